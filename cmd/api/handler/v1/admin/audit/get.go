@@ -0,0 +1,208 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+const defaultLimit = 20
+
+type GetListRequestQuery struct {
+	User       *string    `query:"user" validate:"required"`
+	Actor      *string    `query:"actor"`
+	Action     *string    `query:"action"`
+	TargetType *string    `query:"targetType" validate:"omitempty,oneof=comment user filter_rule vote_ring"`
+	TargetID   *string    `query:"targetId"`
+	From       *time.Time `query:"from"`
+	To         *time.Time `query:"to"`
+	Limit      *int       `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset     *int       `query:"offset" validate:"omitempty,gte=0"`
+	Format     string     `query:"format" validate:"omitempty,oneof=json csv"`
+}
+
+type auditLog struct {
+	ID         int       `json:"id"`
+	Actor      string    `json:"actor"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"targetType"`
+	TargetID   string    `json:"targetId"`
+	Reason     *string   `json:"reason"`
+	RequestID  *string   `json:"requestId"`
+	IP         *string   `json:"ip"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	reqQuery := new(GetListRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getListDBInput(reqQuery)
+	logs, total, err := h.db.ReadAuditLogs(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if reqQuery.Format == "csv" {
+		h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+		return writeCSV(c, logs)
+	}
+
+	respBody := mapDBAuditLogsToRespAuditLogs(logs)
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: respBody,
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "TargetType":
+				return fmt.Errorf("targetType is invalid, must be one of comment, user, filter_rule or vote_ring")
+			case "Limit":
+				return fmt.Errorf("limit is invalid, must be between 1 and 100")
+			case "Offset":
+				return fmt.Errorf("offset is invalid")
+			case "Format":
+				return fmt.Errorf("format is invalid, must be one of json or csv")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func getListDBInput(reqQuery *GetListRequestQuery) *model.ReadAuditLogsInput {
+	inp := new(model.ReadAuditLogsInput)
+
+	inp.Actor = reqQuery.Actor
+	inp.Action = reqQuery.Action
+	inp.TargetType = reqQuery.TargetType
+	inp.TargetID = reqQuery.TargetID
+	inp.From = reqQuery.From
+	inp.To = reqQuery.To
+
+	// NOTE: csv export contains every matching record
+	if reqQuery.Format == "csv" {
+		inp.Limit = -1
+		return inp
+	}
+
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func writeCSV(c echo.Context, logs []*model.AuditLog) error {
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="audit.csv"`)
+	c.Response().WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(c.Response())
+	if err := w.Write([]string{
+		"id", "createdAt", "actor", "action", "targetType", "targetId", "reason", "requestId", "ip",
+	}); err != nil {
+		return err
+	}
+
+	for _, a := range logs {
+		if err := w.Write([]string{
+			strconv.Itoa(a.ID),
+			a.CreatedAt.Format(time.RFC3339),
+			csvCell(a.Actor),
+			csvCell(a.Action),
+			csvCell(a.TargetType),
+			csvCell(a.TargetID),
+			csvCell(utils.ToValue(a.Reason)),
+			csvCell(utils.ToValue(a.RequestID)),
+			csvCell(utils.ToValue(a.IP)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// csvCell keeps spreadsheets from reading a user supplied value as a formula.
+func csvCell(val string) string {
+	if val != "" && strings.ContainsRune("=+-@\t\r", rune(val[0])) {
+		return "'" + val
+	}
+
+	return val
+}
+
+func mapDBAuditLogsToRespAuditLogs(logs []*model.AuditLog) []*auditLog {
+	respLogs := make([]*auditLog, len(logs))
+
+	for i, a := range logs {
+		respLogs[i] = &auditLog{
+			ID:         a.ID,
+			Actor:      a.Actor,
+			Action:     a.Action,
+			TargetType: a.TargetType,
+			TargetID:   a.TargetID,
+			Reason:     a.Reason,
+			RequestID:  a.RequestID,
+			IP:         a.IP,
+			CreatedAt:  a.CreatedAt,
+		}
+	}
+
+	return respLogs
+}
@@ -0,0 +1,62 @@
+package filters
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type rule struct {
+	ID        int       `json:"id"`
+	Kind      string    `json:"kind"`
+	Action    string    `json:"action"`
+	Value     string    `json:"value"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	rules, err := h.db.ReadFilterRules(ctx)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	respBody := mapDBRulesToRespRules(rules)
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: respBody,
+	})
+}
+
+func mapDBRulesToRespRules(rs []*model.FilterRule) []*rule {
+	respRs := make([]*rule, len(rs))
+
+	for i, r := range rs {
+		respRs[i] = &rule{
+			ID:        r.ID,
+			Kind:      string(r.Kind),
+			Action:    string(r.Action),
+			Value:     r.Value,
+			Enabled:   r.Enabled,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+		}
+	}
+
+	return respRs
+}
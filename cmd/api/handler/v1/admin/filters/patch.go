@@ -0,0 +1,182 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PatchRequestParam struct {
+	ID *int `param:"id" validate:"required,gt=0"`
+}
+
+type PatchRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type PatchRequestBody struct {
+	Action  *string `xml:"action" json:"action,omitempty" form:"action" validate:"omitempty,oneof=reject mask moderate"`
+	Value   *string `xml:"value" json:"value,omitempty" form:"value" validate:"omitempty,min=1"`
+	Enabled *bool   `xml:"enabled" json:"enabled,omitempty" form:"enabled"`
+}
+
+func (h *Handler) Edit(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Edit", "path", c.Path())
+
+	reqParam := new(PatchRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.patchRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(PatchRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.patchRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqBody, err := h.patchRequestBody(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.patchRequestValidationErrors(ctx, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := patchDBInput(reqBody, reqParam.ID, reqQuery.User)
+	if err := h.db.UpdateFilterRule(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Edit", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) patchRequestParamValidationErrors(_ context.Context, reqParam *PatchRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) patchRequestQueryValidationErrors(_ context.Context, reqParam *PatchRequestQuery) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) patchRequestBody(_ context.Context, c echo.Context) (*PatchRequestBody, error) {
+	reqBody := new(PatchRequestBody)
+	if err := (&echo.DefaultBinder{}).BindBody(c, reqBody); err != nil {
+		return nil, err
+	}
+
+	return reqBody, nil
+}
+
+func (h *Handler) patchRequestValidationErrors(_ context.Context, reqBody *PatchRequestBody) error {
+	if err := h.validate.Struct(reqBody); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Action":
+				return fmt.Errorf("action is invalid, must be one of reject, mask or moderate")
+			case "Value":
+				return fmt.Errorf("value is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func patchDBInput(reqBody *PatchRequestBody, id *int, username *string) *model.UpdateFilterRuleInput {
+	inp := new(model.UpdateFilterRuleInput)
+
+	if reqBody == nil {
+		return inp
+	}
+
+	inp.ID = id
+	if reqBody.Action != nil {
+		action := constant.FilterAction(*reqBody.Action)
+		inp.Action = &action
+	}
+	inp.Value = reqBody.Value
+	inp.Enabled = reqBody.Enabled
+	inp.Actor = username
+
+	return inp
+}
@@ -0,0 +1,143 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type PostRequestBody struct {
+	Kind    string `xml:"kind" json:"kind" form:"kind" validate:"required,oneof=max_length banned_words link_limit"`
+	Action  string `xml:"action" json:"action" form:"action" validate:"required,oneof=reject mask moderate"`
+	Value   string `xml:"value" json:"value" form:"value" validate:"required"`
+	Enabled *bool  `xml:"enabled" json:"enabled,omitempty" form:"enabled"`
+}
+
+func (h *Handler) Add(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Add", "path", c.Path())
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqBody, err := h.postRequestBody(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestValidationErrors(ctx, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := postDBInput(reqBody, reqQuery.User)
+	if err := h.db.CreateFilterRule(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: db add fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Add", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqParam *PostRequestQuery) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postRequestBody(_ context.Context, c echo.Context) (*PostRequestBody, error) {
+	reqBody := new(PostRequestBody)
+	if err := (&echo.DefaultBinder{}).BindBody(c, reqBody); err != nil {
+		return nil, err
+	}
+
+	return reqBody, nil
+}
+
+func (h *Handler) postRequestValidationErrors(_ context.Context, reqBody *PostRequestBody) error {
+	if err := h.validate.Struct(reqBody); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Kind":
+				return fmt.Errorf("kind is invalid, must be one of max_length, banned_words or link_limit")
+			case "Action":
+				return fmt.Errorf("action is invalid, must be one of reject, mask or moderate")
+			case "Value":
+				return fmt.Errorf("value is required")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func postDBInput(reqBody *PostRequestBody, username *string) *model.CreateFilterRuleInput {
+	inp := new(model.CreateFilterRuleInput)
+
+	if reqBody == nil {
+		return inp
+	}
+
+	inp.Kind = constant.FilterKind(reqBody.Kind)
+	inp.Action = constant.FilterAction(reqBody.Action)
+	inp.Value = reqBody.Value
+	inp.Enabled = reqBody.Enabled == nil || *reqBody.Enabled
+	inp.Actor = username
+
+	return inp
+}
@@ -0,0 +1,126 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type DeleteBanRequestParam struct {
+	Username *string `param:"username" validate:"required"`
+}
+
+type DeleteBanRequestQuery struct {
+	Reason *string `query:"reason" validate:"omitempty,max=500"`
+}
+
+// Unban lifts the sanction of the user before it expires
+func (h *Handler) Unban(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Unban", "path", c.Path())
+
+	reqParam := new(DeleteBanRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Unban:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.deleteBanRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Unban:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(DeleteBanRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Unban:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.deleteBanRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Unban:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	actor := ctx.Value(constant.UserCtxKey).(*model.User)
+
+	dbInput := deleteBanDBInput(reqQuery, reqParam.Username, actor)
+	if err := h.db.UnbanUser(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Unban:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Unban", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) deleteBanRequestParamValidationErrors(_ context.Context, reqParam *DeleteBanRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Username":
+				return fmt.Errorf("username is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) deleteBanRequestQueryValidationErrors(_ context.Context, reqQuery *DeleteBanRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Reason":
+				return fmt.Errorf("reason is too long")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func deleteBanDBInput(reqQuery *DeleteBanRequestQuery, username *string, actor *model.User) *model.UnbanUserInput {
+	inp := new(model.UnbanUserInput)
+
+	inp.Username = username
+	inp.Reason = reqQuery.Reason
+	inp.Actor = &actor.Username
+	inp.AnyRole = permission.Has(actor.Role, permission.UserManage)
+
+	return inp
+}
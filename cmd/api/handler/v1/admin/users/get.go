@@ -0,0 +1,75 @@
+package users
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type user struct {
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	Ban       *ban      `json:"ban"`
+}
+
+type ban struct {
+	Status string     `json:"status"`
+	Until  *time.Time `json:"until"`
+	Reason *string    `json:"reason"`
+}
+
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	users, err := h.db.ReadUsers(ctx)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	respBody := mapDBUsersToRespUsers(users)
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: respBody,
+	})
+}
+
+func mapDBUsersToRespUsers(us []*model.User) []*user {
+	respUs := make([]*user, len(us))
+
+	for i, u := range us {
+		respUs[i] = mapDBUserToRespUser(u)
+	}
+
+	return respUs
+}
+
+func mapDBUserToRespUser(u *model.User) *user {
+	respU := &user{
+		Username:  u.Username,
+		Role:      string(u.Role),
+		CreatedAt: u.CreatedAt,
+	}
+
+	if u.Ban != nil {
+		respU.Ban = &ban{
+			Status: string(u.Ban.Status),
+			Until:  u.Ban.Until,
+			Reason: u.Ban.Reason,
+		}
+	}
+
+	return respU
+}
@@ -0,0 +1,146 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostBanRequestParam struct {
+	Username *string `param:"username" validate:"required"`
+}
+
+type PostBanRequestBody struct {
+	Status string     `xml:"status" json:"status" form:"status" validate:"required,oneof=suspended shadow_banned banned"`
+	Until  *time.Time `xml:"until" json:"until,omitempty" form:"until"`
+	Reason *string    `xml:"reason" json:"reason,omitempty" form:"reason" validate:"omitempty,max=500"`
+}
+
+// Ban sanctions the user, moderators can sanction plain users only
+func (h *Handler) Ban(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Ban", "path", c.Path())
+
+	reqParam := new(PostBanRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Ban:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postBanRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Ban:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqBody, err := h.postBanRequestBody(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Ban:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postBanRequestValidationErrors(ctx, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Ban:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	actor := ctx.Value(constant.UserCtxKey).(*model.User)
+
+	dbInput := postBanDBInput(reqBody, reqParam.Username, actor)
+	if err := h.db.BanUser(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Ban:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Ban", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) postBanRequestParamValidationErrors(_ context.Context, reqParam *PostBanRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Username":
+				return fmt.Errorf("username is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postBanRequestBody(_ context.Context, c echo.Context) (*PostBanRequestBody, error) {
+	reqBody := new(PostBanRequestBody)
+	if err := (&echo.DefaultBinder{}).BindBody(c, reqBody); err != nil {
+		return nil, err
+	}
+
+	return reqBody, nil
+}
+
+func (h *Handler) postBanRequestValidationErrors(_ context.Context, reqBody *PostBanRequestBody) error {
+	if err := h.validate.Struct(reqBody); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Status":
+				return fmt.Errorf("status is invalid, must be one of suspended, shadow_banned or banned")
+			case "Reason":
+				return fmt.Errorf("reason is too long")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func postBanDBInput(reqBody *PostBanRequestBody, username *string, actor *model.User) *model.BanUserInput {
+	inp := new(model.BanUserInput)
+
+	if reqBody == nil {
+		return inp
+	}
+
+	inp.Username = username
+	inp.Status = constant.BanStatus(reqBody.Status)
+	inp.Until = reqBody.Until
+	inp.Reason = reqBody.Reason
+	inp.Actor = &actor.Username
+	inp.AnyRole = permission.Has(actor.Role, permission.UserManage)
+
+	return inp
+}
@@ -0,0 +1,51 @@
+package attachments
+
+import (
+	"context"
+	"time"
+)
+
+const collectInterval = time.Hour
+
+// CollectOrphans deletes uploads which were never attached to a comment, or whose comment was deleted,
+// once they are older than ttl. It runs every hour until ctx is done.
+func (h *Handler) CollectOrphans(ctx context.Context, ttl time.Duration) {
+	go func() {
+		ticker := time.NewTicker(collectInterval)
+		defer ticker.Stop()
+
+		for {
+			h.collectOrphans(ctx, ttl)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (h *Handler) collectOrphans(ctx context.Context, ttl time.Duration) {
+	as, err := h.db.ReadOrphanAttachments(ctx, ttl)
+	if err != nil {
+		h.log.ErrorContext(ctx, "fail CollectOrphans:: db read fail", "error", err)
+		return
+	}
+
+	deleted := 0
+	for _, a := range as {
+		// NOTE: the record goes first, so a file is never referenced after it was removed
+		if err := h.db.DeleteOrphanAttachment(ctx, a.ID); err != nil {
+			h.log.WarnContext(ctx, "skip CollectOrphans", "id", a.ID, "error", err)
+			continue
+		}
+
+		h.removeFiles(ctx, a.StorageKey, a.ThumbnailKey)
+		deleted++
+	}
+
+	if deleted > 0 {
+		h.log.InfoContext(ctx, "success CollectOrphans", "deleted", deleted)
+	}
+}
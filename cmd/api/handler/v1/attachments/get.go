@@ -0,0 +1,137 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type GetRequestParam struct {
+	ID int `param:"id" validate:"required,gt=0"`
+}
+
+type GetRequestQuery struct {
+	User string `query:"user"`
+}
+
+// Read serves the attachment file
+func (h *Handler) Read(c echo.Context) error {
+	return h.serve(c, false)
+}
+
+// ReadThumbnail serves the preview of an image attachment
+func (h *Handler) ReadThumbnail(c echo.Context) error {
+	return h.serve(c, true)
+}
+
+func (h *Handler) serve(c echo.Context, thumbnail bool) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Read", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	a, err := h.db.ReadAttachment(ctx, reqParam.ID, reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	key, contentType := a.StorageKey, a.ContentType
+	if thumbnail {
+		if a.ThumbnailKey == nil {
+			h.log.ErrorContext(
+				ctx,
+				"fail Read:: attachment has no thumbnail",
+				"path", c.Path(),
+			)
+			return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: "attachment has no thumbnail"}})
+		}
+		key, contentType = *a.ThumbnailKey, "image/png"
+	}
+
+	f, err := h.storage.Open(ctx, key)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: storage open fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+	defer f.Close()
+
+	// NOTE: files never change, a new upload gets a new id
+	c.Response().Header().Set("Cache-Control", "private, max-age=86400")
+	c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+	if !thumbnail {
+		c.Response().Header().Set(echo.HeaderContentDisposition, contentDisposition(a.ContentType, a.Name))
+	}
+
+	h.log.InfoContext(ctx, "success Read", "path", c.Path())
+	return c.Stream(http.StatusOK, contentType, f)
+}
+
+func (h *Handler) getRequestParamValidationErrors(_ context.Context, reqParam *GetRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// contentDisposition shows images inline, every other file is downloaded
+func contentDisposition(contentType, name string) string {
+	disposition := "attachment"
+	if contentType == "image/png" || contentType == "image/jpeg" || contentType == "image/gif" {
+		disposition = "inline"
+	}
+
+	return fmt.Sprintf("%s; filename=%q", disposition, name)
+}
@@ -0,0 +1,22 @@
+package attachments
+
+import (
+	"log/slog"
+
+	"github.com/go-playground/validator/v10"
+
+	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+	"github.com/talgat-ruby/interactive-comments-api/internal/storage"
+)
+
+type Handler struct {
+	db       dbT.DB
+	validate *validator.Validate
+	log      *slog.Logger
+	storage  storage.Storage
+	maxSize  int64
+}
+
+func New(db dbT.DB, v *validator.Validate, l *slog.Logger, s storage.Storage, maxSize int64) *Handler {
+	return &Handler{db, v, l, s, maxSize}
+}
@@ -0,0 +1,236 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/attachment"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type uploaded struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	ContentType  string `json:"contentType"`
+	Size         int    `json:"size"`
+	Width        *int   `json:"width,omitempty"`
+	Height       *int   `json:"height,omitempty"`
+	Url          string `json:"url"`
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty"`
+}
+
+// Add stores the file in the multipart "file" field. The returned id is passed in "attachments" when creating a comment.
+func (h *Handler) Add(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Add", "path", c.Path())
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail Add:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	name, data, err := h.postFile(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: file reading error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	f, err := attachment.Process(data)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: file processing error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput, err := h.store(ctx, reqQuery.User, name, f)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: storage put fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusInternalServerError, response.ErrorWithMessage{Error: response.WithMessage{Message: "attachment could not be stored"}})
+	}
+
+	id, err := h.db.CreateAttachment(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: db create fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		h.removeFiles(context.WithoutCancel(ctx), dbInput.StorageKey, dbInput.ThumbnailKey)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Add", "path", c.Path())
+	return c.JSON(http.StatusCreated, response.Data{
+		Data: &uploaded{
+			ID:           id,
+			Name:         dbInput.Name,
+			ContentType:  dbInput.ContentType,
+			Size:         dbInput.Size,
+			Width:        dbInput.Width,
+			Height:       dbInput.Height,
+			Url:          attachment.URL(id),
+			ThumbnailUrl: attachment.ThumbnailURL(id, dbInput.ThumbnailKey != nil),
+		},
+	})
+}
+
+func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqQuery *PostRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postFile(_ context.Context, c echo.Context) (string, []byte, error) {
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return "", nil, fmt.Errorf("file is required")
+	}
+
+	if fh.Size > h.maxSize {
+		return "", nil, fmt.Errorf("file must not be larger than %d bytes", h.maxSize)
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, h.maxSize+1))
+	if err != nil {
+		return "", nil, err
+	} else if int64(len(data)) > h.maxSize {
+		return "", nil, fmt.Errorf("file must not be larger than %d bytes", h.maxSize)
+	}
+
+	return filepath.Base(fh.Filename), data, nil
+}
+
+// store puts the file and its thumbnail into the storage under random keys
+func (h *Handler) store(ctx context.Context, owner *string, name string, f *attachment.File) (*model.CreateAttachmentInput, error) {
+	key, err := newStorageKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.storage.Put(ctx, key, bytes.NewReader(f.Data)); err != nil {
+		return nil, err
+	}
+
+	inp := &model.CreateAttachmentInput{
+		Owner:       owner,
+		Name:        name,
+		ContentType: f.ContentType,
+		Size:        len(f.Data),
+		StorageKey:  key,
+		Width:       f.Width,
+		Height:      f.Height,
+	}
+
+	if f.Thumbnail != nil {
+		thumbnailKey := key + "_thumbnail"
+		if err := h.storage.Put(ctx, thumbnailKey, bytes.NewReader(f.Thumbnail)); err != nil {
+			h.removeFiles(context.WithoutCancel(ctx), key, nil)
+			return nil, err
+		}
+		inp.ThumbnailKey = &thumbnailKey
+	}
+
+	return inp, nil
+}
+
+func (h *Handler) removeFiles(ctx context.Context, key string, thumbnailKey *string) {
+	if err := h.storage.Delete(ctx, key); err != nil {
+		h.log.ErrorContext(ctx, "fail removeFiles", "key", key, "error", err)
+	}
+	if thumbnailKey != nil {
+		if err := h.storage.Delete(ctx, *thumbnailKey); err != nil {
+			h.log.ErrorContext(ctx, "fail removeFiles", "key", *thumbnailKey, "error", err)
+		}
+	}
+}
+
+// newStorageKey returns a random key, the first byte is used as a directory to keep directories small
+func newStorageKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	k := hex.EncodeToString(b)
+	return k[:2] + "/" + k, nil
+}
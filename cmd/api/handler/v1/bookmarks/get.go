@@ -0,0 +1,133 @@
+package bookmarks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+const defaultLimit = 20
+
+type GetListRequestQuery struct {
+	User   string `query:"user" validate:"required"`
+	Limit  *int   `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int   `query:"offset" validate:"omitempty,gte=0"`
+}
+
+type bookmark struct {
+	ID           int       `json:"id"`
+	Content      string    `json:"content"`
+	ContentHtml  string    `json:"contentHtml"`
+	Author       string    `json:"author"`
+	AvatarUrl    string    `json:"avatarUrl"`
+	ParentID     *int      `json:"parentId"`
+	CreatedAt    time.Time `json:"createdAt"`
+	BookmarkedAt time.Time `json:"bookmarkedAt"`
+}
+
+// ReadList lists comments the user saved
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	reqQuery := new(GetListRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getListDBInput(reqQuery)
+	bookmarks, total, err := h.db.ReadBookmarks(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: mapDBBookmarksToRespBookmarks(bookmarks),
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "Limit":
+				return fmt.Errorf("limit is invalid, must be between 1 and 100")
+			case "Offset":
+				return fmt.Errorf("offset is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func getListDBInput(reqQuery *GetListRequestQuery) *model.ReadBookmarksInput {
+	inp := new(model.ReadBookmarksInput)
+
+	inp.Username = reqQuery.User
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func mapDBBookmarksToRespBookmarks(bs []*model.Bookmark) []*bookmark {
+	respBs := make([]*bookmark, len(bs))
+
+	for i, b := range bs {
+		respBs[i] = &bookmark{
+			ID:           b.ID,
+			Content:      b.Content,
+			ContentHtml:  b.ContentHtml,
+			Author:       b.Author,
+			AvatarUrl:    b.AvatarUrl,
+			ParentID:     b.ParentID,
+			CreatedAt:    b.CreatedAt,
+			BookmarkedAt: b.BookmarkedAt,
+		}
+	}
+
+	return respBs
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 	"github.com/talgat-ruby/interactive-comments-api/internal/response"
 )
 
@@ -17,7 +18,8 @@ type DeleteRequestParam struct {
 }
 
 type DeleteRequestQuery struct {
-	User *string `query:"user" validate:"required"`
+	User   *string `query:"user" validate:"required"`
+	Reason *string `query:"reason" validate:"omitempty,max=500"`
 }
 
 func (h *Handler) Delete(c echo.Context) error {
@@ -64,7 +66,33 @@ func (h *Handler) Delete(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
-	dbInput := deleteDBInput(reqParam.ID, reqQuery.User)
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail Delete:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := deleteDBInput(
+		reqParam.ID,
+		reqQuery,
+		permission.Has(user.Role, permission.CommentDeleteAny),
+		permission.Has(user.Role, permission.CommentRemoveAny),
+	)
 	if err := h.db.DeleteComment(ctx, dbInput); err != nil {
 		h.log.ErrorContext(
 			ctx,
@@ -100,6 +128,8 @@ func (h *Handler) deleteRequestQueryValidationErrors(_ context.Context, reqParam
 			switch err.StructField() {
 			case "User":
 				return fmt.Errorf("user is invalid")
+			case "Reason":
+				return fmt.Errorf("reason is too long")
 			}
 		}
 
@@ -109,11 +139,14 @@ func (h *Handler) deleteRequestQueryValidationErrors(_ context.Context, reqParam
 	return nil
 }
 
-func deleteDBInput(id *int, username *string) *model.DeleteCommentInput {
+func deleteDBInput(id *int, reqQuery *DeleteRequestQuery, anyAuthor bool, removeAny bool) *model.DeleteCommentInput {
 	inp := new(model.DeleteCommentInput)
 
 	inp.ID = id
-	inp.Username = username
+	inp.Username = reqQuery.User
+	inp.AnyAuthor = anyAuthor
+	inp.RemoveAny = removeAny
+	inp.Reason = reqQuery.Reason
 
 	return inp
 }
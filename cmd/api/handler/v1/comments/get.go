@@ -1,15 +1,31 @@
 package comments
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 	"github.com/talgat-ruby/interactive-comments-api/internal/response"
 )
 
 type GetListRequestQuery struct {
+	User   string `query:"user"`
+	Status string `query:"status" validate:"omitempty,oneof=answered unanswered"`
+}
+
+type GetRequestParam struct {
+	ID *int `param:"id" validate:"required,gt=0"`
+}
+
+type GetRequestQuery struct {
 	User string `query:"user"`
 }
 
@@ -18,27 +34,86 @@ type GetListResponseBody struct {
 }
 
 type commentReply struct {
-	ID        int    `json:"id"`
-	Content   string `json:"content"`
-	Author    string `json:"author"`
-	AvatarUrl string `json:"avatarUrl"`
-	Likes     int    `json:"likes"`
-	Duration  string `json:"duration"`
-	IsMine    bool   `json:"isMine"`
-	MyRate    int    `json:"myRate"`
-	Addressee string `json:"addressee"`
+	ID              int                     `json:"id"`
+	Content         string                  `json:"content"`
+	ContentHtml     string                  `json:"contentHtml"`
+	Author          string                  `json:"author"`
+	AvatarUrl       string                  `json:"avatarUrl"`
+	AuthorKarma     int                     `json:"authorKarma"`
+	Likes           int                     `json:"likes"`
+	Duration        string                  `json:"duration"`
+	IsMine          bool                    `json:"isMine"`
+	MyRate          int                     `json:"myRate"`
+	IsBookmarked    bool                    `json:"isBookmarked"`
+	IsNew           bool                    `json:"isNew"`
+	SpamScore       *float64                `json:"spamScore,omitempty"`
+	Addressee       string                  `json:"addressee"`
+	Collapsed       bool                    `json:"collapsed"`
+	CollapsedReason constant.CollapseReason `json:"collapsedReason,omitempty"`
+	Attachments     []*commentAttachment    `json:"attachments"`
+	Quote           *quote                  `json:"quote"`
+	QuotedBy        []int                   `json:"quotedBy"`
+	Accepted        bool                    `json:"accepted"`
 }
 
 type comment struct {
-	ID        int             `json:"id"`
-	Content   string          `json:"content"`
-	Author    string          `json:"author"`
-	AvatarUrl string          `json:"avatarUrl"`
-	Likes     int             `json:"likes"`
-	Duration  string          `json:"duration"`
-	IsMine    bool            `json:"isMine"`
-	MyRate    int             `json:"myRate"`
-	Replies   []*commentReply `json:"replies"`
+	ID              int                     `json:"id"`
+	Content         string                  `json:"content"`
+	ContentHtml     string                  `json:"contentHtml"`
+	Author          string                  `json:"author"`
+	AvatarUrl       string                  `json:"avatarUrl"`
+	AuthorKarma     int                     `json:"authorKarma"`
+	Likes           int                     `json:"likes"`
+	Duration        string                  `json:"duration"`
+	IsMine          bool                    `json:"isMine"`
+	MyRate          int                     `json:"myRate"`
+	IsBookmarked    bool                    `json:"isBookmarked"`
+	IsNew           bool                    `json:"isNew"`
+	SpamScore       *float64                `json:"spamScore,omitempty"`
+	Collapsed       bool                    `json:"collapsed"`
+	CollapsedReason constant.CollapseReason `json:"collapsedReason,omitempty"`
+	Attachments     []*commentAttachment    `json:"attachments"`
+	Quote           *quote                  `json:"quote"`
+	QuotedBy        []int                   `json:"quotedBy"`
+	Pinned          bool                    `json:"pinned"`
+	Featured        bool                    `json:"featured"`
+	Locked          bool                    `json:"locked"`
+	Poll            *poll                   `json:"poll"`
+	NewCount        int                     `json:"newCount"`
+	Replies         []*commentReply         `json:"replies"`
+}
+
+type quote struct {
+	ID      int    `json:"id"`
+	Author  string `json:"author"`
+	Excerpt string `json:"excerpt"`
+}
+
+type poll struct {
+	Question string        `json:"question"`
+	Multiple bool          `json:"multiple"`
+	ClosesAt *time.Time    `json:"closesAt"`
+	Closed   bool          `json:"closed"`
+	Voters   int           `json:"voters"`
+	Options  []*pollOption `json:"options"`
+}
+
+type pollOption struct {
+	ID    int    `json:"id"`
+	Text  string `json:"text"`
+	Votes int    `json:"votes"`
+	Voted bool   `json:"voted"`
+}
+
+type commentAttachment struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	ContentType  string `json:"contentType"`
+	Size         int    `json:"size"`
+	Width        *int   `json:"width,omitempty"`
+	Height       *int   `json:"height,omitempty"`
+	Url          string `json:"url"`
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty"`
 }
 
 func (h *Handler) ReadList(c echo.Context) error {
@@ -51,7 +126,19 @@ func (h *Handler) ReadList(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
-	comments, err := h.db.ReadComments(ctx, reqQuery.User)
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	comments, err := h.db.ReadComments(ctx, &model.ReadCommentsInput{
+		User:   reqQuery.User,
+		Status: constant.ThreadStatus(reqQuery.Status),
+	})
 	if err != nil {
 		h.log.ErrorContext(
 			ctx,
@@ -62,7 +149,7 @@ func (h *Handler) ReadList(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
-	respBody := mapDBCommentsToRespComments(comments)
+	respBody := mapDBCommentsToRespComments(comments, h.showSpamScore(ctx, reqQuery.User))
 
 	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
 	return c.JSON(http.StatusOK, response.Data{
@@ -70,50 +157,330 @@ func (h *Handler) ReadList(c echo.Context) error {
 	})
 }
 
-func mapDBCommentsToRespComments(cs []*model.Comment) []*comment {
+// Read returns the thread of the comment, the comment and its replies are not collapsed for their low score
+func (h *Handler) Read(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Read", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	comment, err := h.db.ReadComment(ctx, &model.ReadCommentInput{
+		ID:   reqParam.ID,
+		User: reqQuery.User,
+	})
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Read", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: mapDBCommentToRespComment(comment, h.showSpamScore(ctx, reqQuery.User)),
+	})
+}
+
+// showSpamScore checks whether the user may see spam scores, only moderators do
+func (h *Handler) showSpamScore(ctx context.Context, username string) bool {
+	if username == "" {
+		return false
+	}
+
+	user, err := h.db.ReadUser(ctx, username)
+	if err != nil {
+		return false
+	}
+
+	return permission.Has(user.Role, permission.ModerationManage)
+}
+
+func (h *Handler) getRequestParamValidationErrors(_ context.Context, reqParam *GetRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Status":
+				return fmt.Errorf("status is invalid, must be answered or unanswered")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func mapDBCommentsToRespComments(cs []*model.Comment, showSpamScore bool) []*comment {
 	respCs := make([]*comment, len(cs))
 
 	for i, c := range cs {
-		respCs[i] = mapDBCommentToRespComment(c)
+		respCs[i] = mapDBCommentToRespComment(c, showSpamScore)
 	}
 
 	return respCs
 }
 
-func mapDBCommentToRespComment(c *model.Comment) *comment {
-	return &comment{
-		ID:        c.ID,
-		Content:   c.Content,
-		Author:    c.Author,
-		AvatarUrl: c.AvatarUrl,
-		Likes:     c.Likes,
-		Duration:  c.Duration,
-		IsMine:    c.IsMine,
-		MyRate:    c.MyRate,
-		Replies:   mapDBCommentRepliesToRespCommentReplies(c.Replies),
+func mapDBCommentToRespComment(c *model.Comment, showSpamScore bool) *comment {
+	respC := &comment{
+		ID:              c.ID,
+		Content:         c.Content,
+		ContentHtml:     c.ContentHtml,
+		Author:          c.Author,
+		AvatarUrl:       c.AvatarUrl,
+		AuthorKarma:     c.AuthorKarma,
+		Likes:           c.Likes,
+		Duration:        c.Duration,
+		IsMine:          c.IsMine,
+		MyRate:          c.MyRate,
+		IsBookmarked:    c.IsBookmarked,
+		IsNew:           c.IsNew,
+		Collapsed:       c.Collapsed,
+		CollapsedReason: c.CollapsedReason,
+		Attachments:     mapDBAttachmentsToRespAttachments(c.Attachments),
+		Quote:           mapDBQuoteToRespQuote(c.Quote),
+		QuotedBy:        c.QuotedBy,
+		Pinned:          c.Pinned,
+		Featured:        c.Featured,
+		Locked:          c.Locked,
+		Poll:            mapDBPollToRespPoll(c.Poll),
+		NewCount:        c.NewCount,
+		Replies:         mapDBCommentRepliesToRespCommentReplies(c.Replies, showSpamScore),
+	}
+
+	if showSpamScore {
+		respC.SpamScore = c.SpamScore
 	}
+
+	return respC
 }
 
-func mapDBCommentRepliesToRespCommentReplies(cs []*model.Reply) []*commentReply {
+func mapDBCommentRepliesToRespCommentReplies(cs []*model.Reply, showSpamScore bool) []*commentReply {
 	respCs := make([]*commentReply, len(cs))
 
 	for i, c := range cs {
-		respCs[i] = mapDBCommentReplyToRespCommentReply(c)
+		respCs[i] = mapDBCommentReplyToRespCommentReply(c, showSpamScore)
 	}
 
 	return respCs
 }
 
-func mapDBCommentReplyToRespCommentReply(c *model.Reply) *commentReply {
-	return &commentReply{
-		ID:        c.ID,
-		Content:   c.Content,
-		Author:    c.Author,
-		AvatarUrl: c.AvatarUrl,
-		Likes:     c.Likes,
-		Duration:  c.Duration,
-		IsMine:    c.IsMine,
-		MyRate:    c.MyRate,
-		Addressee: c.Addressee,
+func mapDBCommentReplyToRespCommentReply(c *model.Reply, showSpamScore bool) *commentReply {
+	respC := &commentReply{
+		ID:              c.ID,
+		Content:         c.Content,
+		ContentHtml:     c.ContentHtml,
+		Author:          c.Author,
+		AvatarUrl:       c.AvatarUrl,
+		AuthorKarma:     c.AuthorKarma,
+		Likes:           c.Likes,
+		Duration:        c.Duration,
+		IsMine:          c.IsMine,
+		MyRate:          c.MyRate,
+		IsBookmarked:    c.IsBookmarked,
+		IsNew:           c.IsNew,
+		Addressee:       c.Addressee,
+		Collapsed:       c.Collapsed,
+		CollapsedReason: c.CollapsedReason,
+		Attachments:     mapDBAttachmentsToRespAttachments(c.Attachments),
+		Quote:           mapDBQuoteToRespQuote(c.Quote),
+		QuotedBy:        c.QuotedBy,
+		Accepted:        c.Accepted,
+	}
+
+	if showSpamScore {
+		respC.SpamScore = c.SpamScore
+	}
+
+	return respC
+}
+
+func mapDBAttachmentsToRespAttachments(as []*model.CommentAttachment) []*commentAttachment {
+	respAs := make([]*commentAttachment, len(as))
+
+	for i, a := range as {
+		respAs[i] = &commentAttachment{
+			ID:           a.ID,
+			Name:         a.Name,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			Width:        a.Width,
+			Height:       a.Height,
+			Url:          a.Url,
+			ThumbnailUrl: a.ThumbnailUrl,
+		}
+	}
+
+	return respAs
+}
+
+func mapDBQuoteToRespQuote(q *model.Quote) *quote {
+	if q == nil {
+		return nil
+	}
+
+	return &quote{
+		ID:      q.ID,
+		Author:  q.Author,
+		Excerpt: q.Excerpt,
 	}
 }
+
+func mapDBPollToRespPoll(p *model.Poll) *poll {
+	if p == nil {
+		return nil
+	}
+
+	respP := &poll{
+		Question: p.Question,
+		Multiple: p.Multiple,
+		ClosesAt: p.ClosesAt,
+		Closed:   p.Closed,
+		Voters:   p.Voters,
+		Options:  make([]*pollOption, len(p.Options)),
+	}
+
+	for i, o := range p.Options {
+		respP.Options[i] = &pollOption{
+			ID:    o.ID,
+			Text:  o.Text,
+			Votes: o.Votes,
+			Voted: o.Voted,
+		}
+	}
+
+	return respP
+}
+
+type GetScheduledRequestQuery struct {
+	User string `query:"user" validate:"required"`
+}
+
+type scheduledComment struct {
+	ID        int       `json:"id"`
+	Content   string    `json:"content"`
+	ParentID  *int      `json:"parentId"`
+	Addressee *string   `json:"addressee"`
+	PublishAt time.Time `json:"publishAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ReadScheduled lists comments of the user waiting to be published
+func (h *Handler) ReadScheduled(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadScheduled", "path", c.Path())
+
+	reqQuery := new(GetScheduledRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadScheduled:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getScheduledRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadScheduled:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	comments, err := h.db.ReadScheduledComments(ctx, reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadScheduled:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success ReadScheduled", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: mapDBScheduledCommentsToRespScheduledComments(comments),
+	})
+}
+
+func (h *Handler) getScheduledRequestQueryValidationErrors(_ context.Context, reqQuery *GetScheduledRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func mapDBScheduledCommentsToRespScheduledComments(cs []*model.ScheduledComment) []*scheduledComment {
+	respCs := make([]*scheduledComment, len(cs))
+
+	for i, c := range cs {
+		respCs[i] = &scheduledComment{
+			ID:        c.ID,
+			Content:   c.Content,
+			ParentID:  c.ParentID,
+			Addressee: c.Addressee,
+			PublishAt: c.PublishAt,
+			CreatedAt: c.CreatedAt,
+		}
+	}
+
+	return respCs
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 	"github.com/talgat-ruby/interactive-comments-api/internal/response"
 )
 
@@ -88,7 +89,28 @@ func (h *Handler) Edit(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
-	dbInput := patchDBInput(reqBody, reqParam.ID, reqQuery.User)
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Edit:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail Edit:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := patchDBInput(reqBody, reqParam.ID, reqQuery.User, permission.Has(user.Role, permission.CommentEditAny))
 	if err := h.db.UpdateComment(ctx, dbInput); err != nil {
 		h.log.ErrorContext(
 			ctx,
@@ -158,7 +180,7 @@ func (h *Handler) patchRequestValidationErrors(_ context.Context, reqBody *Patch
 	return nil
 }
 
-func patchDBInput(reqBody *PatchRequestBody, id *int, username *string) *model.UpdateCommentInput {
+func patchDBInput(reqBody *PatchRequestBody, id *int, username *string, anyAuthor bool) *model.UpdateCommentInput {
 	inp := new(model.UpdateCommentInput)
 
 	if reqBody == nil {
@@ -167,6 +189,7 @@ func patchDBInput(reqBody *PatchRequestBody, id *int, username *string) *model.U
 
 	inp.ID = id
 	inp.Author = username
+	inp.AnyAuthor = anyAuthor
 	inp.Content = reqBody.Content
 
 	return inp
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/attachment"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 	"github.com/talgat-ruby/interactive-comments-api/internal/response"
 )
 
@@ -17,9 +20,20 @@ type PostRequestQuery struct {
 }
 
 type PostRequestBody struct {
-	ParentID  *int    `xml:"parentId" json:"parentId,omitempty" form:"parentId" validate:"omitempty,gt=0"`
-	Addressee *string `xml:"addressee" json:"addressee,omitempty" form:"addressee" validate:"required_with=ParentID,omitempty,gt=0"`
-	Content   string  `xml:"content" json:"content" form:"content" validate:"required"`
+	ParentID        *int             `xml:"parentId" json:"parentId,omitempty" form:"parentId" validate:"omitempty,gt=0"`
+	Addressee       *string          `xml:"addressee" json:"addressee,omitempty" form:"addressee" validate:"required_with=ParentID,omitempty,gt=0"`
+	Content         string           `xml:"content" json:"content" form:"content" validate:"required"`
+	QuotedCommentID *int             `xml:"quotedCommentId" json:"quotedCommentId,omitempty" form:"quotedCommentId" validate:"excluded_without=ParentID,omitempty,gt=0"`
+	Attachments     []int            `xml:"attachments" json:"attachments,omitempty" form:"attachments" validate:"omitempty,max=4,dive,gt=0"`
+	PublishAt       *time.Time       `xml:"publishAt" json:"publishAt,omitempty" form:"publishAt" validate:"omitempty,gt"`
+	Poll            *PostRequestPoll `xml:"poll" json:"poll,omitempty" form:"poll" validate:"excluded_with=ParentID"`
+}
+
+type PostRequestPoll struct {
+	Question string     `xml:"question" json:"question" form:"question" validate:"required,max=300"`
+	Options  []string   `xml:"options" json:"options" form:"options" validate:"min=2,max=10,dive,required,max=100"`
+	Multiple bool       `xml:"multiple" json:"multiple" form:"multiple"`
+	ClosesAt *time.Time `xml:"closesAt" json:"closesAt,omitempty" form:"closesAt" validate:"omitempty,gt"`
 }
 
 func (h *Handler) Add(c echo.Context) error {
@@ -66,6 +80,27 @@ func (h *Handler) Add(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail Add:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
 	dbInput := postDBInput(reqBody, reqQuery.User)
 	if err := h.db.CreateComment(ctx, dbInput); err != nil {
 		h.log.ErrorContext(
@@ -81,6 +116,124 @@ func (h *Handler) Add(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+type PostAcceptRequestParam struct {
+	ID *int `param:"id" validate:"required,gt=0"`
+}
+
+// Accept marks the reply as the accepted answer of its thread. Only the thread author and moderators may do so.
+func (h *Handler) Accept(c echo.Context) error {
+	return h.setAccepted(c, true)
+}
+
+// Unaccept takes back the accepted answer mark of the reply
+func (h *Handler) Unaccept(c echo.Context) error {
+	return h.setAccepted(c, false)
+}
+
+func (h *Handler) setAccepted(c echo.Context, accepted bool) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start SetAccepted", "path", c.Path(), "accepted", accepted)
+
+	reqParam := new(PostAcceptRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postAcceptRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail SetAccepted:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := &model.AcceptAnswerInput{
+		ReplyID:   reqParam.ID,
+		Username:  reqQuery.User,
+		AnyAuthor: permission.Has(user.Role, permission.ModerationManage),
+	}
+	if accepted {
+		err = h.db.AcceptAnswer(ctx, dbInput)
+	} else {
+		err = h.db.UnacceptAnswer(ctx, dbInput)
+	}
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetAccepted:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success SetAccepted", "path", c.Path(), "accepted", accepted)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) postAcceptRequestParamValidationErrors(_ context.Context, reqParam *PostAcceptRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqParam *PostRequestQuery) error {
 	if err := h.validate.Struct(reqParam); err != nil {
 		for _, err := range err.(validator.ValidationErrors) {
@@ -115,6 +268,20 @@ func (h *Handler) postRequestValidationErrors(_ context.Context, reqBody *PostRe
 				return fmt.Errorf("addressee is invalid, is required if parentId presented")
 			case "Content":
 				return fmt.Errorf("content is required")
+			case "QuotedCommentID":
+				return fmt.Errorf("quotedCommentId is invalid, only replies can quote")
+			case "Attachments":
+				return fmt.Errorf("attachments are invalid, at most %d attachment ids are allowed", attachment.MaxPerComment)
+			case "PublishAt":
+				return fmt.Errorf("publishAt is invalid, must be in the future")
+			case "Poll":
+				return fmt.Errorf("poll is invalid, only top-level comments can have a poll")
+			case "Question":
+				return fmt.Errorf("poll question is required, at most 300 characters")
+			case "Options":
+				return fmt.Errorf("poll options are invalid, 2 to 10 non-empty options of at most 100 characters are allowed")
+			case "ClosesAt":
+				return fmt.Errorf("poll closesAt is invalid, must be in the future")
 			}
 		}
 
@@ -135,6 +302,18 @@ func postDBInput(reqBody *PostRequestBody, username *string) *model.CreateCommen
 	inp.Content = reqBody.Content
 	inp.ParentID = reqBody.ParentID
 	inp.Addressee = reqBody.Addressee
+	inp.QuotedCommentID = reqBody.QuotedCommentID
+	inp.Attachments = reqBody.Attachments
+	inp.PublishAt = reqBody.PublishAt
+
+	if reqBody.Poll != nil {
+		inp.Poll = &model.CreatePollInput{
+			Question: reqBody.Poll.Question,
+			Options:  reqBody.Poll.Options,
+			Multiple: reqBody.Poll.Multiple,
+			ClosesAt: reqBody.Poll.ClosesAt,
+		}
+	}
 
 	return inp
 }
@@ -0,0 +1,30 @@
+package comments
+
+import (
+	"context"
+	"time"
+)
+
+const publishInterval = time.Minute
+
+// PublishScheduled publishes scheduled comments once their publish time has come. It runs every minute until ctx is done.
+func (h *Handler) PublishScheduled(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(publishInterval)
+		defer ticker.Stop()
+
+		for {
+			if n, err := h.db.PublishScheduledComments(ctx); err != nil {
+				h.log.ErrorContext(ctx, "fail PublishScheduled:: db update fail", "error", err)
+			} else if n > 0 {
+				h.log.InfoContext(ctx, "success PublishScheduled", "published", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,122 @@
+package drafts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type GetRequestParam struct {
+	ThreadKey string `param:"threadKey" validate:"required,max=64"`
+}
+
+type GetRequestQuery struct {
+	User string `query:"user" validate:"required"`
+}
+
+type draft struct {
+	ThreadKey string    `json:"threadKey"`
+	Content   string    `json:"content"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (h *Handler) Read(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Read", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	d, err := h.db.ReadDraft(ctx, reqQuery.User, reqParam.ThreadKey)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Read", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: &draft{
+			ThreadKey: d.ThreadKey,
+			Content:   d.Content,
+			UpdatedAt: d.UpdatedAt,
+		},
+	})
+}
+
+func (h *Handler) getRequestParamValidationErrors(_ context.Context, reqParam *GetRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ThreadKey":
+				return fmt.Errorf("threadKey is invalid, must be at most 64 characters")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) getRequestQueryValidationErrors(_ context.Context, reqQuery *GetRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package drafts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PutRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type PutRequestBody struct {
+	Content string `xml:"content" json:"content" form:"content" validate:"required,max=10000"`
+}
+
+// Save stores the draft of the user for the thread key, replacing the previous one
+func (h *Handler) Save(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Save", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(PutRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.putRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqBody := new(PutRequestBody)
+	if err := (&echo.DefaultBinder{}).BindBody(c, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.putRequestBodyValidationErrors(ctx, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := putDBInput(reqParam, reqQuery, reqBody)
+	if err := h.db.UpsertDraft(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Save:: db upsert fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Save", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) putRequestQueryValidationErrors(_ context.Context, reqQuery *PutRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) putRequestBodyValidationErrors(_ context.Context, reqBody *PutRequestBody) error {
+	if err := h.validate.Struct(reqBody); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Content":
+				return fmt.Errorf("content is invalid, must be between 1 and 10000 characters")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func putDBInput(reqParam *GetRequestParam, reqQuery *PutRequestQuery, reqBody *PutRequestBody) *model.UpsertDraftInput {
+	inp := new(model.UpsertDraftInput)
+
+	if reqParam == nil || reqQuery == nil || reqBody == nil {
+		return inp
+	}
+
+	inp.Username = reqQuery.User
+	inp.ThreadKey = reqParam.ThreadKey
+	inp.Content = reqBody.Content
+
+	return inp
+}
@@ -65,6 +65,27 @@ func (h *Handler) AddOrEdit(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
 	}
 
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail AddOrEdit:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail AddOrEdit:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
 	dbInput := postDBInput(reqBody, reqQuery.User)
 	if err := h.db.UpsertLike(ctx, dbInput); err != nil {
 		h.log.ErrorContext(
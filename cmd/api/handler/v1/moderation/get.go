@@ -0,0 +1,129 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type GetListRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type queueReport struct {
+	ID        int       `json:"id"`
+	Author    string    `json:"author"`
+	Reason    string    `json:"reason"`
+	Details   *string   `json:"details,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type queueItem struct {
+	CommentID int            `json:"commentId"`
+	Content   string         `json:"content"`
+	Author    string         `json:"author"`
+	ParentID  *int           `json:"parentId,omitempty"`
+	Status    string         `json:"status"`
+	SpamScore *float64       `json:"spamScore"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Reports   []*queueReport `json:"reports"`
+}
+
+func (h *Handler) ReadQueue(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadQueue", "path", c.Path())
+
+	reqQuery := new(GetListRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadQueue:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadQueue:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	items, err := h.db.ReadModerationQueue(ctx)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadQueue:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	respBody := mapDBQueueItemsToRespQueueItems(items)
+
+	h.log.InfoContext(ctx, "success ReadQueue", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: respBody,
+	})
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func mapDBQueueItemsToRespQueueItems(items []*model.ModerationQueueItem) []*queueItem {
+	respItems := make([]*queueItem, len(items))
+
+	for i, item := range items {
+		respItems[i] = mapDBQueueItemToRespQueueItem(item)
+	}
+
+	return respItems
+}
+
+func mapDBQueueItemToRespQueueItem(item *model.ModerationQueueItem) *queueItem {
+	reports := make([]*queueReport, len(item.Reports))
+	for i, r := range item.Reports {
+		reports[i] = &queueReport{
+			ID:        r.ID,
+			Author:    r.Author,
+			Reason:    r.Reason,
+			Details:   r.Details,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+
+	return &queueItem{
+		CommentID: item.CommentID,
+		Content:   item.Content,
+		Author:    item.Author,
+		ParentID:  item.ParentID,
+		Status:    item.Status,
+		SpamScore: item.SpamScore,
+		CreatedAt: item.CreatedAt,
+		Reports:   reports,
+	}
+}
@@ -0,0 +1,132 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostRequestParam struct {
+	ID     *int   `param:"id" validate:"required,gt=0"`
+	Action string `param:"action" validate:"required,oneof=approve remove dismiss"`
+}
+
+type PostRequestQuery struct {
+	User   *string `query:"user" validate:"required"`
+	Reason *string `query:"reason" validate:"omitempty,max=500"`
+}
+
+func (h *Handler) Resolve(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Resolve", "path", c.Path())
+
+	reqParam := new(PostRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Resolve:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Resolve:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Resolve:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Resolve:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := postDBInput(reqParam, reqQuery)
+	if err := h.db.ResolveModeration(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Resolve:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Resolve", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) postRequestParamValidationErrors(_ context.Context, reqParam *PostRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			case "Action":
+				return fmt.Errorf("action is invalid, must be one of approve, remove or dismiss")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqParam *PostRequestQuery) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "Reason":
+				return fmt.Errorf("reason is too long")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func postDBInput(reqParam *PostRequestParam, reqQuery *PostRequestQuery) *model.ResolveModerationInput {
+	inp := new(model.ResolveModerationInput)
+
+	if reqParam == nil || reqQuery == nil {
+		return inp
+	}
+
+	inp.CommentID = reqParam.ID
+	inp.Moderator = reqQuery.User
+	inp.Action = constant.ModerationAction(reqParam.Action)
+	inp.Reason = reqQuery.Reason
+
+	return inp
+}
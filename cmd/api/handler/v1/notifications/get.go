@@ -0,0 +1,136 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+const defaultLimit = 20
+
+type GetListRequestQuery struct {
+	User   string `query:"user" validate:"required"`
+	Unread bool   `query:"unread"`
+	Limit  *int   `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int   `query:"offset" validate:"omitempty,gte=0"`
+}
+
+type notification struct {
+	ID        int                       `json:"id"`
+	Kind      constant.NotificationKind `json:"kind"`
+	CommentID int                       `json:"commentId"`
+	ThreadID  int                       `json:"threadId"`
+	Actor     string                    `json:"actor"`
+	Excerpt   string                    `json:"excerpt"`
+	Read      bool                      `json:"read"`
+	CreatedAt time.Time                 `json:"createdAt"`
+}
+
+// ReadList lists the inbox of the user
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	reqQuery := new(GetListRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getListDBInput(reqQuery)
+	notifications, total, err := h.db.ReadNotifications(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: mapDBNotificationsToRespNotifications(notifications),
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "Limit":
+				return fmt.Errorf("limit is invalid, must be between 1 and 100")
+			case "Offset":
+				return fmt.Errorf("offset is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func getListDBInput(reqQuery *GetListRequestQuery) *model.ReadNotificationsInput {
+	inp := new(model.ReadNotificationsInput)
+
+	inp.Username = reqQuery.User
+	inp.UnreadOnly = reqQuery.Unread
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func mapDBNotificationsToRespNotifications(ns []*model.Notification) []*notification {
+	respNs := make([]*notification, len(ns))
+
+	for i, n := range ns {
+		respNs[i] = &notification{
+			ID:        n.ID,
+			Kind:      n.Kind,
+			CommentID: n.CommentID,
+			ThreadID:  n.ThreadID,
+			Actor:     n.Actor,
+			Excerpt:   n.Excerpt,
+			Read:      n.Read,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+
+	return respNs
+}
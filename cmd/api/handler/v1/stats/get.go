@@ -0,0 +1,219 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+const defaultRange = 30 * 24 * time.Hour
+
+type GetRequestQuery struct {
+	User *string    `query:"user" validate:"required"`
+	From *time.Time `query:"from"`
+	To   *time.Time `query:"to"`
+}
+
+type stats struct {
+	From                time.Time   `json:"from"`
+	To                  time.Time   `json:"to"`
+	Comments            *comments   `json:"comments"`
+	ActiveCommenters    int         `json:"activeCommenters"`
+	TopThreads          []*thread   `json:"topThreads"`
+	Votes               *votes      `json:"votes"`
+	AvgTimeToFirstReply *float64    `json:"avgTimeToFirstReply"`
+	Moderation          *moderation `json:"moderation"`
+}
+
+type comments struct {
+	Total   int       `json:"total"`
+	PerDay  []*bucket `json:"perDay"`
+	PerWeek []*bucket `json:"perWeek"`
+}
+
+type bucket struct {
+	Period string `json:"period"`
+	Count  int    `json:"count"`
+}
+
+type thread struct {
+	ID      int    `json:"id"`
+	Author  string `json:"author"`
+	Excerpt string `json:"excerpt"`
+	Replies int    `json:"replies"`
+	Likes   int    `json:"likes"`
+}
+
+type votes struct {
+	Up   int `json:"up"`
+	Down int `json:"down"`
+}
+
+type moderation struct {
+	ReportsOpened    int      `json:"reportsOpened"`
+	ReportsUpheld    int      `json:"reportsUpheld"`
+	ReportsRejected  int      `json:"reportsRejected"`
+	ReportsDismissed int      `json:"reportsDismissed"`
+	AvgTimeToResolve *float64 `json:"avgTimeToResolve"`
+	CommentsApproved int      `json:"commentsApproved"`
+	CommentsRemoved  int      `json:"commentsRemoved"`
+}
+
+// Read returns activity stats between from and to, the last 30 days by default. Results are cached for the requested range.
+func (h *Handler) Read(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Read", "path", c.Path())
+
+	reqQuery := new(GetRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	now := time.Now()
+	key := cacheKey(reqQuery)
+	s := h.cache.get(now, key)
+	if s == nil {
+		var err error
+		s, err = h.db.ReadStats(ctx, getDBInput(reqQuery, now))
+		if err != nil {
+			h.log.ErrorContext(
+				ctx,
+				"fail Read:: db read fail",
+				"path", c.Path(),
+				"error", err,
+			)
+			return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+		}
+
+		h.cache.set(now, key, s)
+	}
+
+	respBody := mapDBStatsToRespStats(s)
+
+	h.log.InfoContext(ctx, "success Read", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: respBody,
+	})
+}
+
+func (h *Handler) getRequestQueryValidationErrors(_ context.Context, reqQuery *GetRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	if reqQuery.From != nil && reqQuery.To != nil && !reqQuery.To.After(*reqQuery.From) {
+		return fmt.Errorf("to is invalid, must be after from")
+	}
+
+	return nil
+}
+
+// cacheKey identifies the requested range, open ends are kept open so that default ranges share an entry
+func cacheKey(reqQuery *GetRequestQuery) string {
+	key := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	return key(reqQuery.From) + "/" + key(reqQuery.To)
+}
+
+func getDBInput(reqQuery *GetRequestQuery, now time.Time) *model.ReadStatsInput {
+	inp := new(model.ReadStatsInput)
+
+	inp.To = now.UTC().Truncate(time.Second)
+	if reqQuery.To != nil {
+		inp.To = *reqQuery.To
+	}
+
+	inp.From = inp.To.Add(-defaultRange)
+	if reqQuery.From != nil {
+		inp.From = *reqQuery.From
+	}
+
+	return inp
+}
+
+func mapDBStatsToRespStats(s *model.Stats) *stats {
+	respS := &stats{
+		From: s.From,
+		To:   s.To,
+		Comments: &comments{
+			Total:   s.Comments,
+			PerDay:  mapDBBucketsToRespBuckets(s.CommentsPerDay),
+			PerWeek: mapDBBucketsToRespBuckets(s.CommentsPerWeek),
+		},
+		ActiveCommenters: s.ActiveCommenters,
+		TopThreads:       make([]*thread, len(s.TopThreads)),
+		Votes: &votes{
+			Up:   s.Upvotes,
+			Down: s.Downvotes,
+		},
+		AvgTimeToFirstReply: s.AvgFirstReply,
+		Moderation: &moderation{
+			ReportsOpened:    s.Moderation.ReportsOpened,
+			ReportsUpheld:    s.Moderation.ReportsUpheld,
+			ReportsRejected:  s.Moderation.ReportsRejected,
+			ReportsDismissed: s.Moderation.ReportsDismissed,
+			AvgTimeToResolve: s.Moderation.AvgResolution,
+			CommentsApproved: s.Moderation.CommentsApproved,
+			CommentsRemoved:  s.Moderation.CommentsRemoved,
+		},
+	}
+
+	for i, t := range s.TopThreads {
+		respS.TopThreads[i] = &thread{
+			ID:      t.ID,
+			Author:  t.Author,
+			Excerpt: t.Excerpt,
+			Replies: t.Replies,
+			Likes:   t.Likes,
+		}
+	}
+
+	return respS
+}
+
+func mapDBBucketsToRespBuckets(bs []*model.StatsBucket) []*bucket {
+	respBs := make([]*bucket, len(bs))
+
+	for i, b := range bs {
+		respBs[i] = &bucket{
+			Period: b.Period,
+			Count:  b.Count,
+		}
+	}
+
+	return respBs
+}
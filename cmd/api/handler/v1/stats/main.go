@@ -0,0 +1,75 @@
+package stats
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+)
+
+type Handler struct {
+	db       dbT.DB
+	validate *validator.Validate
+	log      *slog.Logger
+	cache    *cache
+}
+
+func New(db dbT.DB, v *validator.Validate, l *slog.Logger, cacheTTL time.Duration) *Handler {
+	return &Handler{db, v, l, newCache(cacheTTL)}
+}
+
+// cache keeps computed stats by requested range for ttl, a ttl of zero disables it
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	stats     *model.Stats
+	expiresAt time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+func (c *cache) get(now time.Time, key string) *model.Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !now.Before(e.expiresAt) {
+		return nil
+	}
+
+	return e.stats
+}
+
+func (c *cache) set(now time.Time, key string, stats *model.Stats) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// NOTE: expired entries are dropped here, so ranges which are not asked again do not pile up
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = &cacheEntry{
+		stats:     stats,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,40 @@
+package subscriptions
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+// Delete stops following the thread of the comment
+func (h *Handler) Delete(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Delete", "path", c.Path())
+
+	reqParam, reqQuery, err := h.bindRequest(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: request error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := subscriptionDBInput(reqParam, reqQuery)
+	if err := h.db.DeleteSubscription(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: db delete fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Delete", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
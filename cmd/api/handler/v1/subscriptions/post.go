@@ -0,0 +1,118 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostRequestParam struct {
+	ID *int `param:"id" validate:"required,gt=0"`
+}
+
+type PostRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+// Add follows the thread of the comment, new replies land in the inbox of the user
+func (h *Handler) Add(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Add", "path", c.Path())
+
+	reqParam, reqQuery, err := h.bindRequest(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: request error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := subscriptionDBInput(reqParam, reqQuery)
+	if err := h.db.CreateSubscription(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Add:: db add fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Add", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindRequest binds and validates the comment id and user shared by Add and Delete
+func (h *Handler) bindRequest(ctx context.Context, c echo.Context) (*PostRequestParam, *PostRequestQuery, error) {
+	reqParam := new(PostRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		return nil, nil, err
+	}
+
+	if err := h.postRequestParamValidationErrors(ctx, reqParam); err != nil {
+		return nil, nil, err
+	}
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		return nil, nil, err
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		return nil, nil, err
+	}
+
+	return reqParam, reqQuery, nil
+}
+
+func (h *Handler) postRequestParamValidationErrors(_ context.Context, reqParam *PostRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqQuery *PostRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func subscriptionDBInput(reqParam *PostRequestParam, reqQuery *PostRequestQuery) *model.SubscriptionInput {
+	inp := new(model.SubscriptionInput)
+
+	if reqParam == nil || reqQuery == nil {
+		return inp
+	}
+
+	inp.Username = reqQuery.User
+	inp.CommentID = reqParam.ID
+
+	return inp
+}
@@ -0,0 +1,10 @@
+package threads
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// Unset takes back a pin, feature or lock of the thread
+func (h *Handler) Unset(c echo.Context) error {
+	return h.setFlag(c, false)
+}
@@ -0,0 +1,138 @@
+package threads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PostRequestParam struct {
+	ID   *int   `param:"id" validate:"required,gt=0"`
+	Flag string `param:"flag" validate:"required,oneof=pin feature lock"`
+}
+
+type PostRequestQuery struct {
+	User   *string `query:"user" validate:"required"`
+	Reason *string `query:"reason" validate:"omitempty,max=500"`
+}
+
+// Set pins, features or locks the thread of a top-level comment
+func (h *Handler) Set(c echo.Context) error {
+	return h.setFlag(c, true)
+}
+
+func (h *Handler) setFlag(c echo.Context, value bool) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start SetFlag", "path", c.Path(), "value", value)
+
+	reqParam := new(PostRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetFlag:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetFlag:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(PostRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetFlag:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.postRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetFlag:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := postDBInput(reqParam, reqQuery, value)
+	if err := h.db.SetThreadFlag(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail SetFlag:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success SetFlag", "path", c.Path(), "value", value)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) postRequestParamValidationErrors(_ context.Context, reqParam *PostRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "ID":
+				return fmt.Errorf("id is invalid")
+			case "Flag":
+				return fmt.Errorf("flag is invalid, must be one of pin, feature or lock")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) postRequestQueryValidationErrors(_ context.Context, reqQuery *PostRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "Reason":
+				return fmt.Errorf("reason is too long")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func postDBInput(reqParam *PostRequestParam, reqQuery *PostRequestQuery, value bool) *model.SetThreadFlagInput {
+	inp := new(model.SetThreadFlagInput)
+
+	if reqParam == nil || reqQuery == nil {
+		return inp
+	}
+
+	inp.CommentID = reqParam.ID
+	inp.Moderator = reqQuery.User
+	inp.Flag = constant.ThreadFlag(reqParam.Flag)
+	inp.Value = value
+	inp.Reason = reqQuery.Reason
+
+	return inp
+}
@@ -0,0 +1,131 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type DeleteRequestParam struct {
+	Username string `param:"username" validate:"required"`
+}
+
+type DeleteRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+// Unblock lifts a block of the user set by the viewer
+func (h *Handler) Unblock(c echo.Context) error {
+	return h.deleteBlock(c, constant.BlockKindBlock)
+}
+
+// Unmute lifts a mute of the user set by the viewer
+func (h *Handler) Unmute(c echo.Context) error {
+	return h.deleteBlock(c, constant.BlockKindMute)
+}
+
+func (h *Handler) deleteBlock(c echo.Context, kind constant.BlockKind) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Delete", "path", c.Path(), "kind", kind)
+
+	reqParam := new(DeleteRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.deleteRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(DeleteRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.deleteRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := deleteDBInput(reqParam, reqQuery.User, kind)
+	if err := h.db.DeleteUserBlock(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Delete:: db delete fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Delete", "path", c.Path(), "kind", kind)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) deleteRequestParamValidationErrors(_ context.Context, reqParam *DeleteRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Username":
+				return fmt.Errorf("username is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) deleteRequestQueryValidationErrors(_ context.Context, reqParam *DeleteRequestQuery) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func deleteDBInput(reqParam *DeleteRequestParam, username *string, kind constant.BlockKind) *model.DeleteUserBlockInput {
+	inp := new(model.DeleteUserBlockInput)
+
+	inp.Blocker = username
+	inp.Blocked = reqParam.Username
+	inp.Kind = kind
+
+	return inp
+}
@@ -0,0 +1,414 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+const defaultLimit = 20
+
+type GetRequestParam struct {
+	Username string `param:"username" validate:"required"`
+}
+
+type GetRequestQuery struct {
+	User string `query:"user"`
+}
+
+type profile struct {
+	Username     string    `json:"username"`
+	DisplayName  *string   `json:"displayName"`
+	Bio          *string   `json:"bio"`
+	AvatarUrl    string    `json:"avatarUrl"`
+	Role         string    `json:"role"`
+	CreatedAt    time.Time `json:"createdAt"`
+	CommentCount int       `json:"commentCount"`
+	Karma        int       `json:"karma"`
+	// CollapseScore is only returned to the user themselves
+	CollapseScore *int `json:"collapseScore,omitempty"`
+}
+
+func (h *Handler) Read(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start Read", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	p, err := h.db.ReadUserProfile(ctx, reqParam.Username, reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail Read:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success Read", "path", c.Path())
+	return c.JSON(http.StatusOK, response.Data{
+		Data: mapDBProfileToRespProfile(p),
+	})
+}
+
+type GetCommentsRequestQuery struct {
+	User   string `query:"user"`
+	Limit  *int   `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int   `query:"offset" validate:"omitempty,gte=0"`
+}
+
+type userComment struct {
+	ID          int       `json:"id"`
+	Content     string    `json:"content"`
+	ContentHtml string    `json:"contentHtml"`
+	ParentID    *int      `json:"parentId"`
+	Addressee   *string   `json:"addressee"`
+	Likes       int       `json:"likes"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ReadComments lists comments and replies written by the user, newest first
+func (h *Handler) ReadComments(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadComments", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadComments:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadComments:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetCommentsRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadComments:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getCommentsRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadComments:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getCommentsDBInput(reqParam, reqQuery)
+	comments, total, err := h.db.ReadUserComments(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadComments:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success ReadComments", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: mapDBUserCommentsToRespUserComments(comments),
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+type activityItem struct {
+	Kind          string    `json:"kind"`
+	CommentID     int       `json:"commentId"`
+	ThreadID      int       `json:"threadId"`
+	CommentAuthor string    `json:"commentAuthor"`
+	Excerpt       string    `json:"excerpt"`
+	Rate          *int      `json:"rate,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ReadActivity lists comments, replies and edits of the user, newest first. Votes are only listed for the user themselves.
+func (h *Handler) ReadActivity(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadActivity", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadActivity:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadActivity:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqQuery := new(GetCommentsRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadActivity:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getCommentsRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadActivity:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getActivityDBInput(reqParam, reqQuery)
+	items, total, err := h.db.ReadUserActivity(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadActivity:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success ReadActivity", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: mapDBActivityItemsToRespActivityItems(items),
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+// ReadAvatar serves the avatar image of the user. Avatar urls contain the etag, so responses are cached forever.
+func (h *Handler) ReadAvatar(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadAvatar", "path", c.Path())
+
+	reqParam := new(GetRequestParam)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadAvatar:: param binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getRequestParamValidationErrors(ctx, reqParam); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadAvatar:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	a, err := h.db.ReadAvatar(ctx, reqParam.Username)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadAvatar:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusNotFound, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	etag := fmt.Sprintf("%q", a.ETag)
+	c.Response().Header().Set("ETag", etag)
+	c.Response().Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	c.Response().Header().Set(echo.HeaderLastModified, a.UpdatedAt.UTC().Format(http.TimeFormat))
+
+	if c.Request().Header.Get("If-None-Match") == etag {
+		h.log.InfoContext(ctx, "success ReadAvatar:: not modified", "path", c.Path())
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	h.log.InfoContext(ctx, "success ReadAvatar", "path", c.Path())
+	return c.Blob(http.StatusOK, a.ContentType, a.Data)
+}
+
+func (h *Handler) getRequestParamValidationErrors(_ context.Context, reqParam *GetRequestParam) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Username":
+				return fmt.Errorf("username is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) getCommentsRequestQueryValidationErrors(_ context.Context, reqQuery *GetCommentsRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "Limit":
+				return fmt.Errorf("limit is invalid, must be between 1 and 100")
+			case "Offset":
+				return fmt.Errorf("offset is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func getCommentsDBInput(reqParam *GetRequestParam, reqQuery *GetCommentsRequestQuery) *model.ReadUserCommentsInput {
+	inp := new(model.ReadUserCommentsInput)
+
+	inp.Username = reqParam.Username
+	inp.Viewer = reqQuery.User
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func getActivityDBInput(reqParam *GetRequestParam, reqQuery *GetCommentsRequestQuery) *model.ReadUserActivityInput {
+	inp := new(model.ReadUserActivityInput)
+
+	inp.Username = reqParam.Username
+	inp.Viewer = reqQuery.User
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func mapDBProfileToRespProfile(p *model.UserProfile) *profile {
+	return &profile{
+		Username:      p.Username,
+		DisplayName:   p.DisplayName,
+		Bio:           p.Bio,
+		AvatarUrl:     p.AvatarUrl,
+		Role:          string(p.Role),
+		CreatedAt:     p.CreatedAt,
+		CommentCount:  p.CommentCount,
+		Karma:         p.Karma,
+		CollapseScore: p.CollapseScore,
+	}
+}
+
+func mapDBUserCommentsToRespUserComments(cs []*model.UserComment) []*userComment {
+	respCs := make([]*userComment, len(cs))
+
+	for i, c := range cs {
+		respCs[i] = &userComment{
+			ID:          c.ID,
+			Content:     c.Content,
+			ContentHtml: c.ContentHtml,
+			ParentID:    c.ParentID,
+			Addressee:   c.Addressee,
+			Likes:       c.Likes,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   c.UpdatedAt,
+		}
+	}
+
+	return respCs
+}
+
+func mapDBActivityItemsToRespActivityItems(items []*model.ActivityItem) []*activityItem {
+	respItems := make([]*activityItem, len(items))
+
+	for i, a := range items {
+		respItems[i] = &activityItem{
+			Kind:          string(a.Kind),
+			CommentID:     a.CommentID,
+			ThreadID:      a.ThreadID,
+			CommentAuthor: a.CommentAuthor,
+			Excerpt:       a.Excerpt,
+			Rate:          a.Rate,
+			CreatedAt:     a.At,
+		}
+	}
+
+	return respItems
+}
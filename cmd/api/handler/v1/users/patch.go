@@ -0,0 +1,160 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+type PatchRequestQuery struct {
+	User *string `query:"user" validate:"required"`
+}
+
+type PatchRequestBody struct {
+	DisplayName   *string `xml:"displayName" json:"displayName,omitempty" form:"displayName" validate:"omitempty,max=50"`
+	Bio           *string `xml:"bio" json:"bio,omitempty" form:"bio" validate:"omitempty,max=500"`
+	CollapseScore *int    `xml:"collapseScore" json:"collapseScore,omitempty" form:"collapseScore"`
+}
+
+// EditMe updates the profile of the current user
+func (h *Handler) EditMe(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start EditMe", "path", c.Path())
+
+	reqQuery := new(PatchRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.patchRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	reqBody, err := h.patchRequestBody(ctx, c)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: body binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.patchRequestValidationErrors(ctx, reqBody); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	user, err := h.db.ReadUser(ctx, *reqQuery.User)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: db read user fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := user.WriteForbidden(); err != nil {
+		h.log.WarnContext(
+			ctx,
+			"fail EditMe:: user is sanctioned",
+			"path", c.Path(),
+			"user", user.Username,
+		)
+		return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := patchDBInput(reqBody, reqQuery.User)
+	if err := h.db.UpdateUserProfile(ctx, dbInput); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail EditMe:: db update fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	h.log.InfoContext(ctx, "success EditMe", "path", c.Path())
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) patchRequestQueryValidationErrors(_ context.Context, reqParam *PatchRequestQuery) error {
+	if err := h.validate.Struct(reqParam); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) patchRequestBody(_ context.Context, c echo.Context) (*PatchRequestBody, error) {
+	reqBody := new(PatchRequestBody)
+	if err := (&echo.DefaultBinder{}).BindBody(c, reqBody); err != nil {
+		return nil, err
+	}
+
+	return reqBody, nil
+}
+
+func (h *Handler) patchRequestValidationErrors(_ context.Context, reqBody *PatchRequestBody) error {
+	if err := h.validate.Struct(reqBody); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "DisplayName":
+				return fmt.Errorf("displayName is too long")
+			case "Bio":
+				return fmt.Errorf("bio is too long")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func patchDBInput(reqBody *PatchRequestBody, username *string) *model.UpdateUserProfileInput {
+	inp := new(model.UpdateUserProfileInput)
+
+	if reqBody == nil {
+		return inp
+	}
+
+	inp.Username = username
+	inp.DisplayName = reqBody.DisplayName
+	inp.Bio = reqBody.Bio
+	inp.CollapseScore = reqBody.CollapseScore
+
+	return inp
+}
@@ -0,0 +1,30 @@
+package voterings
+
+import (
+	"context"
+	"time"
+)
+
+const detectInterval = time.Hour
+
+// DetectRings looks for vote rings every hour until ctx is done
+func (h *Handler) DetectRings(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(detectInterval)
+		defer ticker.Stop()
+
+		for {
+			if n, err := h.db.DetectVoteRings(ctx); err != nil {
+				h.log.ErrorContext(ctx, "fail DetectRings:: db update fail", "error", err)
+			} else if n > 0 {
+				h.log.WarnContext(ctx, "success DetectRings", "pending", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,136 @@
+package voterings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+const defaultLimit = 20
+
+type GetListRequestQuery struct {
+	User   *string `query:"user" validate:"required"`
+	Status string  `query:"status" validate:"omitempty,oneof=pending nullified dismissed"`
+	Limit  *int    `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int    `query:"offset" validate:"omitempty,gte=0"`
+}
+
+type voteRing struct {
+	ID         int        `json:"id"`
+	Members    []string   `json:"members"`
+	Votes      int        `json:"votes"`
+	Status     string     `json:"status"`
+	DetectedAt time.Time  `json:"detectedAt"`
+	ResolvedBy *string    `json:"resolvedBy"`
+	ResolvedAt *time.Time `json:"resolvedAt"`
+}
+
+func (h *Handler) ReadList(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.log.InfoContext(ctx, "start ReadList", "path", c.Path())
+
+	reqQuery := new(GetListRequestQuery)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: query binding error",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	if err := h.getListRequestQueryValidationErrors(ctx, reqQuery); err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: validation errors",
+			"path", c.Path(),
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	dbInput := getListDBInput(reqQuery)
+	rings, total, err := h.db.ReadVoteRings(ctx, dbInput)
+	if err != nil {
+		h.log.ErrorContext(
+			ctx,
+			"fail ReadList:: db read fail",
+			"path", c.Path(),
+			"error", err,
+		)
+		return c.JSON(http.StatusBadRequest, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+	}
+
+	respBody := mapDBVoteRingsToRespVoteRings(rings)
+
+	h.log.InfoContext(ctx, "success ReadList", "path", c.Path())
+	return c.JSON(http.StatusOK, response.DataWithPagination{
+		Data: respBody,
+		Pagination: response.Pagination{
+			Limit:  dbInput.Limit,
+			Offset: dbInput.Offset,
+			Total:  total,
+		},
+	})
+}
+
+func (h *Handler) getListRequestQueryValidationErrors(_ context.Context, reqQuery *GetListRequestQuery) error {
+	if err := h.validate.Struct(reqQuery); err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch err.StructField() {
+			case "User":
+				return fmt.Errorf("user is invalid")
+			case "Status":
+				return fmt.Errorf("status is invalid, must be one of pending, nullified or dismissed")
+			case "Limit":
+				return fmt.Errorf("limit is invalid, must be between 1 and 100")
+			case "Offset":
+				return fmt.Errorf("offset is invalid")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func getListDBInput(reqQuery *GetListRequestQuery) *model.ReadVoteRingsInput {
+	inp := new(model.ReadVoteRingsInput)
+
+	inp.Status = constant.VoteRingStatus(reqQuery.Status)
+	inp.Limit = defaultLimit
+	if reqQuery.Limit != nil {
+		inp.Limit = *reqQuery.Limit
+	}
+	inp.Offset = utils.ToValue(reqQuery.Offset)
+
+	return inp
+}
+
+func mapDBVoteRingsToRespVoteRings(rings []*model.VoteRing) []*voteRing {
+	respRings := make([]*voteRing, len(rings))
+
+	for i, r := range rings {
+		respRings[i] = &voteRing{
+			ID:         r.ID,
+			Members:    r.Members,
+			Votes:      r.Votes,
+			Status:     string(r.Status),
+			DetectedAt: r.DetectedAt,
+			ResolvedBy: r.ResolvedBy,
+			ResolvedAt: r.ResolvedAt,
+		}
+	}
+
+	return respRings
+}
@@ -10,22 +10,25 @@ import (
 
 func (m *middlewareObject) Logger(ctx context.Context, app *echo.Echo) {
 	app.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus:   true,
-		LogURI:      true,
-		LogError:    true,
-		HandleError: true, // forwards error to the global error handler, so it can decide appropriate status code
+		LogStatus:    true,
+		LogURI:       true,
+		LogError:     true,
+		LogRequestID: true,
+		HandleError:  true, // forwards error to the global error handler, so it can decide appropriate status code
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
 			if v.Error == nil {
 				m.api.GetLog().LogAttrs(
 					ctx, slog.LevelInfo, "REQUEST",
 					slog.String("uri", v.URI),
 					slog.Int("status", v.Status),
+					slog.String("request_id", v.RequestID),
 				)
 			} else {
 				m.api.GetLog().LogAttrs(
 					ctx, slog.LevelError, "REQUEST_ERROR",
 					slog.String("uri", v.URI),
 					slog.Int("status", v.Status),
+					slog.String("request_id", v.RequestID),
 					slog.String("err", v.Error.Error()),
 				)
 			}
@@ -3,16 +3,19 @@ package middleware
 import (
 	"github.com/talgat-ruby/interactive-comments-api/cmd/api/types"
 	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+	"github.com/talgat-ruby/interactive-comments-api/internal/ratelimit"
 )
 
 type middlewareObject struct {
-	api types.Api
-	db  dbT.DB
+	api     types.Api
+	db      dbT.DB
+	limiter *ratelimit.Store
 }
 
 func New(api types.Api, db dbT.DB) types.Middleware {
 	return &middlewareObject{
-		api: api,
-		db:  db,
+		api:     api,
+		db:      db,
+		limiter: ratelimit.NewStore(),
 	}
 }
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+// Permission allows the request only when the user from the "user" query parameter is granted p.
+// The loaded user is put into the request context under constant.UserCtxKey.
+func (m *middlewareObject) Permission(p permission.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			username := c.QueryParam("user")
+			if username == "" {
+				return c.JSON(http.StatusUnauthorized, response.ErrorWithMessage{Error: response.WithMessage{Message: "user is invalid"}})
+			}
+
+			user, err := m.db.ReadUser(ctx, username)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, response.ErrorWithMessage{Error: response.WithMessage{Message: err.Error()}})
+			}
+
+			if !permission.Has(user.Role, p) {
+				m.api.GetLog().WarnContext(
+					ctx,
+					"permission denied",
+					"path", c.Path(),
+					"user", user.Username,
+					"permission", p,
+				)
+				return c.JSON(http.StatusForbidden, response.ErrorWithMessage{Error: response.WithMessage{Message: "you do not have permission to perform this action"}})
+			}
+
+			c.SetRequest(c.Request().WithContext(context.WithValue(ctx, constant.UserCtxKey, user)))
+
+			return next(c)
+		}
+	}
+}
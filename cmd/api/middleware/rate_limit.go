@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talgat-ruby/interactive-comments-api/configs"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/ratelimit"
+	"github.com/talgat-ruby/interactive-comments-api/internal/response"
+)
+
+const rateLimitSyncInterval = time.Minute
+
+// RateLimit spends a token from the budget of scope for both the user from the "user" query parameter
+// and the client ip, the request is rejected with 429 once either of them runs out.
+func (m *middlewareObject) RateLimit(scope constant.RateLimitScope) echo.MiddlewareFunc {
+	limit := m.rateLimit(scope)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			keys := []string{fmt.Sprintf("%s:ip:%s", scope, c.RealIP())}
+			if username := c.QueryParam("user"); username != "" {
+				keys = append(keys, fmt.Sprintf("%s:user:%s", scope, username))
+			}
+
+			res := m.limiter.Take(time.Now(), limit, keys...)
+
+			h := c.Response().Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(seconds(res.Reset)))
+
+			if !res.Allowed {
+				ctx := c.Request().Context()
+				m.api.GetLog().WarnContext(
+					ctx,
+					"rate limit exceeded",
+					"path", c.Path(),
+					"scope", scope,
+					"keys", keys,
+				)
+
+				h.Set("Retry-After", strconv.Itoa(seconds(res.RetryAfter)))
+				return c.JSON(http.StatusTooManyRequests, response.ErrorWithMessage{Error: response.WithMessage{Message: "too many requests, please try again later"}})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// KeepRateLimits periodically forgets refilled buckets until ctx is done. With persistence enabled
+// buckets are loaded from the database first and saved back on every tick and on shutdown.
+// The returned channel is closed once the final save is done.
+func (m *middlewareObject) KeepRateLimits(ctx context.Context) <-chan struct{} {
+	persist := m.api.GetConf().RateLimitPersist
+
+	if persist {
+		buckets, err := m.db.ReadRateLimitBuckets(ctx)
+		if err != nil {
+			m.api.GetLog().ErrorContext(ctx, "fail KeepRateLimits:: read buckets", "error", err)
+		} else {
+			m.limiter.Restore(buckets)
+		}
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(rateLimitSyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				if persist {
+					m.saveRateLimits(context.WithoutCancel(ctx))
+				}
+				return
+			case now := <-ticker.C:
+				m.limiter.Cleanup(now)
+				if persist {
+					m.saveRateLimits(ctx)
+				}
+			}
+		}
+	}()
+
+	return done
+}
+
+func (m *middlewareObject) saveRateLimits(ctx context.Context) {
+	if err := m.db.SaveRateLimitBuckets(ctx, m.limiter.Snapshot()); err != nil {
+		m.api.GetLog().ErrorContext(ctx, "fail KeepRateLimits:: save buckets", "error", err)
+	}
+}
+
+func (m *middlewareObject) rateLimit(scope constant.RateLimitScope) ratelimit.Limit {
+	conf := m.api.GetConf()
+
+	var l configs.RateLimit
+	switch scope {
+	case constant.RateLimitScopeCreate:
+		l = conf.RateLimitCreate
+	case constant.RateLimitScopeEdit:
+		l = conf.RateLimitEdit
+	case constant.RateLimitScopeVote:
+		l = conf.RateLimitVote
+	case constant.RateLimitScopeRead:
+		l = conf.RateLimitRead
+	}
+
+	return ratelimit.Limit{Burst: l.Requests, Per: l.Per}
+}
+
+// seconds rounds d up to whole seconds as used by Retry-After and RateLimit-Reset headers
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
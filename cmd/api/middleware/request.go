@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+// RequestID gives every request a server generated id, it is sent back in X-Request-Id header
+// and put into the request context under constant.RequestIDCtxKey.
+// An inbound X-Request-Id is dropped, the id ends up in the audit log and must not be chosen by clients.
+func (m *middlewareObject) RequestID(_ context.Context, app *echo.Echo) {
+	app.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Request().Header.Del(echo.HeaderXRequestID)
+
+			return next(c)
+		}
+	})
+	app.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		Generator: generateRequestID,
+		RequestIDHandler: func(c echo.Context, id string) {
+			ctx := context.WithValue(c.Request().Context(), constant.RequestIDCtxKey, id)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	}))
+}
+
+func generateRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// RealIP puts the client ip into the request context under constant.RealIPCtxKey.
+func (m *middlewareObject) RealIP(_ context.Context, app *echo.Echo) {
+	app.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := context.WithValue(c.Request().Context(), constant.RealIPCtxKey, c.RealIP())
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	})
+}
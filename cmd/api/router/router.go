@@ -6,16 +6,33 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/attachments"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/comments"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/stats"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/voterings"
 	"github.com/talgat-ruby/interactive-comments-api/cmd/api/middleware"
 	apiT "github.com/talgat-ruby/interactive-comments-api/cmd/api/types"
 	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+	"github.com/talgat-ruby/interactive-comments-api/internal/storage"
 )
 
-// SetupRoutes setup router api
-func SetupRoutes(ctx context.Context, app *echo.Echo, api apiT.Api, db dbT.DB, v *validator.Validate) {
+// SetupRoutes setup router api, the returned channel is closed once background state is saved after ctx is done
+func SetupRoutes(ctx context.Context, app *echo.Echo, api apiT.Api, db dbT.DB, v *validator.Validate) <-chan struct{} {
 	m := middleware.New(api, db)
+	m.RequestID(ctx, app)
+	m.RealIP(ctx, app)
 	m.Logger(ctx, app)
+	done := m.KeepRateLimits(ctx)
+
+	conf := api.GetConf()
+	ah := attachments.New(db, v, api.GetLog(), storage.NewDisk(conf.AttachmentDir), conf.AttachmentMaxSize)
+	ah.CollectOrphans(ctx, conf.AttachmentOrphanTTL)
+	comments.New(db, v, api.GetLog()).PublishScheduled(ctx)
+	voterings.New(db, v, api.GetLog()).DetectRings(ctx)
+	sh := stats.New(db, v, api.GetLog(), conf.StatsCacheTTL)
 
 	group := app.Group("/api")
-	v1Group(group, db, v, api.GetLog())
+	v1Group(group, db, v, api.GetLog(), m, ah, sh)
+
+	return done
 }
@@ -6,29 +6,201 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 
+	adminAudit "github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/admin/audit"
+	adminFilters "github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/admin/filters"
+	adminUsers "github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/admin/users"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/attachments"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/bookmarks"
 	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/comments"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/drafts"
 	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/likes"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/moderation"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/notifications"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/polls"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/reports"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/spam"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/stats"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/subscriptions"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/threads"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/users"
+	"github.com/talgat-ruby/interactive-comments-api/cmd/api/handler/v1/voterings"
+	apiT "github.com/talgat-ruby/interactive-comments-api/cmd/api/types"
 	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 )
 
-func v1Group(api *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger) {
+func v1Group(api *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware, ah *attachments.Handler, sh *stats.Handler) {
 	g := api.Group("/v1")
 
-	v1formsRouter(g, db, v, l)
-	v1likesRouter(g, db, v, l)
+	v1formsRouter(g, db, v, l, m)
+	v1attachmentsRouter(g, ah, m)
+	v1likesRouter(g, db, v, l, m)
+	v1pollsRouter(g, db, v, l, m)
+	v1reportsRouter(g, db, v, l, m)
+	v1subscriptionsRouter(g, db, v, l, m)
+	v1bookmarksRouter(g, db, v, l, m)
+	v1draftsRouter(g, db, v, l, m)
+	v1notificationsRouter(g, db, v, l, m)
+	v1usersRouter(g, db, v, l, m)
+	v1moderationRouter(g, db, v, l, m)
+	v1spamRouter(g, db, v, l, m)
+	v1threadsRouter(g, db, v, l, m)
+	v1voteringsRouter(g, db, v, l, m)
+	v1adminUsersRouter(g, db, v, l, m)
+	v1adminBansRouter(g, db, v, l, m)
+	v1adminAuditRouter(g, db, v, l, m)
+	v1adminFiltersRouter(g, db, v, l, m)
+	v1statsRouter(g, sh, m)
 }
 
-func v1formsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger) {
+func v1formsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
 	h := comments.New(db, v, l)
 
-	v1.GET("/comments", h.ReadList)
-	v1.POST("/comments", h.Add)
-	v1.PATCH("/comments/:id", h.Edit)
-	v1.DELETE("/comments/:id", h.Delete)
+	v1.GET("/comments", h.ReadList, m.RateLimit(constant.RateLimitScopeRead))
+	v1.GET("/comments/:id", h.Read, m.RateLimit(constant.RateLimitScopeRead))
+	v1.POST("/comments", h.Add, m.RateLimit(constant.RateLimitScopeCreate))
+	v1.PATCH("/comments/:id", h.Edit, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/comments/:id", h.Delete, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.GET("/users/me/scheduled", h.ReadScheduled, m.RateLimit(constant.RateLimitScopeRead))
+	v1.POST("/comments/:id/accept", h.Accept, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/comments/:id/accept", h.Unaccept, m.RateLimit(constant.RateLimitScopeEdit))
 }
 
-func v1likesRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger) {
+func v1attachmentsRouter(v1 *echo.Group, h *attachments.Handler, m apiT.Middleware) {
+	v1.POST("/attachments", h.Add, m.RateLimit(constant.RateLimitScopeCreate))
+	v1.GET("/attachments/:id", h.Read)
+	v1.GET("/attachments/:id/thumbnail", h.ReadThumbnail)
+}
+
+func v1likesRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
 	h := likes.New(db, v, l)
 
-	v1.POST("/likes", h.AddOrEdit)
+	v1.POST("/likes", h.AddOrEdit, m.RateLimit(constant.RateLimitScopeVote))
+}
+
+func v1pollsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := polls.New(db, v, l)
+
+	v1.POST("/comments/:id/poll/votes", h.Vote, m.RateLimit(constant.RateLimitScopeVote))
+}
+
+func v1reportsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := reports.New(db, v, l)
+
+	v1.POST("/comments/:id/reports", h.Add, m.RateLimit(constant.RateLimitScopeCreate))
+}
+
+func v1subscriptionsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := subscriptions.New(db, v, l)
+
+	v1.POST("/comments/:id/subscription", h.Add, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/comments/:id/subscription", h.Delete, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.GET("/users/me/subscriptions", h.ReadList, m.RateLimit(constant.RateLimitScopeRead))
+}
+
+func v1bookmarksRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := bookmarks.New(db, v, l)
+
+	v1.POST("/comments/:id/bookmark", h.Add, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/comments/:id/bookmark", h.Delete, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.GET("/users/me/bookmarks", h.ReadList, m.RateLimit(constant.RateLimitScopeRead))
+}
+
+func v1draftsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := drafts.New(db, v, l)
+
+	v1.GET("/drafts/:threadKey", h.Read, m.RateLimit(constant.RateLimitScopeRead))
+	v1.PUT("/drafts/:threadKey", h.Save, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/drafts/:threadKey", h.Delete, m.RateLimit(constant.RateLimitScopeEdit))
+}
+
+func v1notificationsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := notifications.New(db, v, l)
+
+	v1.GET("/users/me/notifications", h.ReadList, m.RateLimit(constant.RateLimitScopeRead))
+	v1.POST("/users/me/notifications/read", h.MarkRead, m.RateLimit(constant.RateLimitScopeEdit))
+}
+
+func v1usersRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := users.New(db, v, l)
+
+	v1.GET("/users/:username", h.Read, m.RateLimit(constant.RateLimitScopeRead))
+	v1.PATCH("/users/me", h.EditMe, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.GET("/users/:username/comments", h.ReadComments, m.RateLimit(constant.RateLimitScopeRead))
+	v1.GET("/users/:username/activity", h.ReadActivity, m.RateLimit(constant.RateLimitScopeRead))
+	v1.GET("/users/:username/avatar", h.ReadAvatar)
+	v1.POST("/users/me/avatar", h.UploadMeAvatar, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.POST("/users/:username/block", h.Block, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/users/:username/block", h.Unblock, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.POST("/users/:username/mute", h.Mute, m.RateLimit(constant.RateLimitScopeEdit))
+	v1.DELETE("/users/:username/mute", h.Unmute, m.RateLimit(constant.RateLimitScopeEdit))
+}
+
+func v1moderationRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := moderation.New(db, v, l)
+	g := v1.Group("/moderation", m.Permission(permission.ModerationManage))
+
+	g.GET("/queue", h.ReadQueue)
+	g.POST("/queue/:id/:action", h.Resolve)
+}
+
+func v1spamRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := spam.New(db, v, l)
+	g := v1.Group("/moderation/comments", m.Permission(permission.ModerationManage))
+
+	g.POST("/:id/spam", h.Train)
+}
+
+func v1threadsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := threads.New(db, v, l)
+	g := v1.Group("/moderation/comments", m.Permission(permission.ModerationManage))
+
+	g.POST("/:id/:flag", h.Set)
+	g.DELETE("/:id/:flag", h.Unset)
+}
+
+func v1voteringsRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := voterings.New(db, v, l)
+	g := v1.Group("/moderation/vote-rings", m.Permission(permission.ModerationManage))
+
+	g.GET("", h.ReadList)
+	g.POST("/:id/:action", h.Resolve)
+}
+
+func v1adminUsersRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := adminUsers.New(db, v, l)
+	g := v1.Group("/admin/users", m.Permission(permission.UserManage))
+
+	g.GET("", h.ReadList)
+	g.PATCH("/:username", h.Edit)
+}
+
+func v1adminBansRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := adminUsers.New(db, v, l)
+	g := v1.Group("/admin/users/:username/ban", m.Permission(permission.UserSanction))
+
+	g.POST("", h.Ban)
+	g.DELETE("", h.Unban)
+}
+
+func v1adminAuditRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := adminAudit.New(db, v, l)
+	g := v1.Group("/admin/audit", m.Permission(permission.UserManage))
+
+	g.GET("", h.ReadList)
+}
+
+func v1adminFiltersRouter(v1 *echo.Group, db dbT.DB, v *validator.Validate, l *slog.Logger, m apiT.Middleware) {
+	h := adminFilters.New(db, v, l)
+	g := v1.Group("/admin/filters", m.Permission(permission.FilterManage))
+
+	g.GET("", h.ReadList)
+	g.POST("", h.Add)
+	g.PATCH("/:id", h.Edit)
+	g.DELETE("/:id", h.Delete)
+}
+
+func v1statsRouter(v1 *echo.Group, h *stats.Handler, m apiT.Middleware) {
+	v1.GET("/stats", h.Read, m.Permission(permission.StatsRead))
 }
@@ -39,11 +39,16 @@ func (s *server) GetLog() *slog.Logger {
 	return s.log
 }
 
-func (s *server) Start(ctx context.Context, cancel context.CancelFunc, db dbT.DB) {
+func (s *server) GetConf() *configs.ApiConfig {
+	return s.conf
+}
+
+func (s *server) Start(ctx context.Context, cancel context.CancelFunc, db dbT.DB) <-chan struct{} {
 	v := validator.New()
 	e := echo.New()
 
 	e.Logger.SetOutput(io.Discard)
+	e.IPExtractor = s.ipExtractor()
 
 	srv := http.Server{
 		Addr:        fmt.Sprintf(":%d", s.conf.Port),
@@ -51,7 +56,7 @@ func (s *server) Start(ctx context.Context, cancel context.CancelFunc, db dbT.DB
 		IdleTimeout: s.conf.IdleTimeout,
 	}
 
-	router.SetupRoutes(ctx, e, s, db, v)
+	done := router.SetupRoutes(ctx, e, s, db, v)
 
 	// Listen from s different goroutine
 	go func() {
@@ -75,4 +80,25 @@ func (s *server) Start(ctx context.Context, cancel context.CancelFunc, db dbT.DB
 			s.log.ErrorContext(ctx, "server shutdown error", "error", err)
 		}
 	}()
+
+	return done
+}
+
+// ipExtractor uses the peer address unless trusted proxies are configured,
+// so clients cannot pick their own address with a forged X-Forwarded-For.
+func (s *server) ipExtractor() echo.IPExtractor {
+	if len(s.conf.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	opts := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, ipNet := range s.conf.TrustedProxies {
+		opts = append(opts, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(opts...)
 }
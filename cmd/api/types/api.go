@@ -7,13 +7,22 @@ import (
 	"github.com/labstack/echo/v4"
 
 	dbT "github.com/talgat-ruby/interactive-comments-api/cmd/db/types"
+	"github.com/talgat-ruby/interactive-comments-api/configs"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/permission"
 )
 
 type Api interface {
-	Start(ctx context.Context, cancel context.CancelFunc, d dbT.DB)
+	Start(ctx context.Context, cancel context.CancelFunc, d dbT.DB) <-chan struct{}
 	GetLog() *slog.Logger
+	GetConf() *configs.ApiConfig
 }
 
 type Middleware interface {
 	Logger(ctx context.Context, app *echo.Echo)
+	RequestID(ctx context.Context, app *echo.Echo)
+	RealIP(ctx context.Context, app *echo.Echo)
+	Permission(p permission.Permission) echo.MiddlewareFunc
+	RateLimit(scope constant.RateLimitScope) echo.MiddlewareFunc
+	KeepRateLimits(ctx context.Context) <-chan struct{}
 }
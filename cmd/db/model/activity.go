@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type ActivityItem struct {
+	Kind      constant.ActivityKind
+	CommentID int
+	ThreadID  int
+	// CommentAuthor differs from the user for votes only
+	CommentAuthor string
+	Excerpt       string
+	// Rate is set for votes only
+	Rate *int
+	At   time.Time
+}
+
+type ReadUserActivityInput struct {
+	Username string
+	Viewer   string
+	Limit    int
+	Offset   int
+}
+
+// userActivity merges comments, replies and edits of the user with the votes they gave, which only the user themselves
+// sees. Every revision the user made to own comments is an edit, with the content it left behind.
+const userActivity = `
+	WITH activity AS (
+		SELECT
+			CASE WHEN c.parent_id IS NULL THEN 'comment' ELSE 'reply' END AS kind,
+			c.id AS comment_id,
+			COALESCE(c.parent_id, c.id) AS thread_id,
+			c.author AS comment_author,
+			c.content AS content,
+			NULL AS rate,
+			c.created_at AS at
+		FROM main.user_ u
+		JOIN main.comment c ON c.author = u.username
+		WHERE u.username = ? AND ` + userCommentFilter + `
+		UNION ALL
+		SELECT 'edit', c.id, COALESCE(c.parent_id, c.id), c.author, r.content, NULL, r.created_at
+		FROM main.user_ u
+		JOIN main.comment c ON c.author = u.username
+		JOIN main.comment_revision r ON r.comment_id = c.id AND r.editor = u.username
+		WHERE u.username = ? AND ` + userCommentFilter + `
+		UNION ALL
+		SELECT 'vote', c.id, COALESCE(c.parent_id, c.id), c.author, c.content, l.rate, l.created_at
+		FROM main.like_ l
+		JOIN main.comment c ON l.comment_id = c.id
+		WHERE l.author = ? AND l.author = ? AND l.rate != 0 AND c.status = 'visible'
+	)
+`
+
+func (m *Model) ReadUserActivity(ctx context.Context, input *ReadUserActivityInput) ([]*ActivityItem, int, error) {
+	m.log.InfoContext(ctx, "start ReadUserActivity")
+
+	var exists bool
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT * FROM main.user_ u WHERE u.username = ?);`,
+		input.Username,
+	).Scan(&exists); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserActivity", "error", err)
+		return nil, 0, err
+	} else if !exists {
+		err := fmt.Errorf("user was not found")
+		m.log.ErrorContext(ctx, "fail ReadUserActivity", "error", err)
+		return nil, 0, err
+	}
+
+	args := []any{
+		input.Username, input.Viewer,
+		input.Username, input.Viewer,
+		input.Username, input.Viewer,
+	}
+
+	var total int
+	if err := m.db.QueryRowContext(ctx, userActivity+`SELECT COUNT(*) FROM activity;`, args...).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserActivity", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := userActivity + `
+		SELECT a.kind, a.comment_id, a.thread_id, a.comment_author, a.content, a.rate, a.at
+		FROM activity a
+		ORDER BY a.at DESC, a.comment_id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, append(args, input.Limit, input.Offset)...)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserActivity", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	items := make([]*ActivityItem, 0)
+	for rows.Next() {
+		a := new(ActivityItem)
+		var content string
+
+		if err = rows.Scan(
+			&a.Kind,
+			&a.CommentID,
+			&a.ThreadID,
+			&a.CommentAuthor,
+			&content,
+			&a.Rate,
+			&a.At,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadUserActivity", "error", err)
+			return nil, 0, err
+		}
+
+		a.Excerpt = excerpt(content)
+		items = append(items, a)
+	}
+
+	m.log.InfoContext(ctx, "success ReadUserActivity")
+	return items, total, nil
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type AcceptAnswerInput struct {
+	ReplyID  *int
+	Username *string
+	// AnyAuthor lets the user accept answers in threads of others
+	AnyAuthor bool
+}
+
+// AcceptAnswer marks the reply as the accepted answer of its thread, replacing the previous one
+func (m *Model) AcceptAnswer(ctx context.Context, input *AcceptAnswerInput) error {
+	m.log.InfoContext(ctx, "start AcceptAnswer")
+
+	sqlStatement := `
+		UPDATE comment
+		SET accepted_reply_id = ?
+		WHERE id = (SELECT r.parent_id FROM comment r WHERE r.id = ? AND r.status = 'visible')
+			AND (author = ? OR ?) AND status = 'visible';
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.ReplyID, input.ReplyID, input.Username, input.AnyAuthor)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail AcceptAnswer", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify id is of a reply in your thread")
+	}
+
+	m.log.InfoContext(ctx, "success AcceptAnswer")
+	return nil
+}
+
+// UnacceptAnswer takes back the accepted answer mark of the reply
+func (m *Model) UnacceptAnswer(ctx context.Context, input *AcceptAnswerInput) error {
+	m.log.InfoContext(ctx, "start UnacceptAnswer")
+
+	sqlStatement := `
+		UPDATE comment
+		SET accepted_reply_id = NULL
+		WHERE accepted_reply_id = ? AND (author = ? OR ?);
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.ReplyID, input.Username, input.AnyAuthor)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UnacceptAnswer", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify id is of an accepted answer in your thread")
+	}
+
+	m.log.InfoContext(ctx, "success UnacceptAnswer")
+	return nil
+}
+
+// threadStatusFilter returns the sql condition keeping top-level comments c of the status
+func threadStatusFilter(status constant.ThreadStatus) string {
+	// NOTE: an answer which is not visible anymore does not resolve the thread
+	answered := `EXISTS (SELECT * FROM main.comment ar WHERE ar.id = c.accepted_reply_id AND ar.status = 'visible')`
+
+	switch status {
+	case constant.ThreadStatusAnswered:
+		return answered
+	case constant.ThreadStatusUnanswered:
+		return "NOT " + answered
+	default:
+		return "1"
+	}
+}
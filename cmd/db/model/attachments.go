@@ -0,0 +1,303 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/attachment"
+)
+
+type Attachment struct {
+	ID           int
+	Owner        string
+	CommentID    *int
+	Name         string
+	ContentType  string
+	Size         int
+	StorageKey   string
+	ThumbnailKey *string
+	Width        *int
+	Height       *int
+	CreatedAt    time.Time
+}
+
+type CommentAttachment struct {
+	ID           int
+	Name         string
+	ContentType  string
+	Size         int
+	Width        *int
+	Height       *int
+	Url          string
+	ThumbnailUrl string
+}
+
+type CreateAttachmentInput struct {
+	Owner        *string
+	Name         string
+	ContentType  string
+	Size         int
+	StorageKey   string
+	ThumbnailKey *string
+	Width        *int
+	Height       *int
+}
+
+func (m *Model) CreateAttachment(ctx context.Context, input *CreateAttachmentInput) (int, error) {
+	m.log.InfoContext(ctx, "start CreateAttachment")
+
+	sqlStatement := `
+		INSERT INTO attachment (owner, name, content_type, size, storage_key, thumbnail_key, width, height)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+	`
+
+	res, err := m.db.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Owner,
+		input.Name,
+		input.ContentType,
+		input.Size,
+		input.StorageKey,
+		input.ThumbnailKey,
+		input.Width,
+		input.Height,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateAttachment", "error", err)
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateAttachment", "error", err)
+		return 0, err
+	}
+
+	m.log.InfoContext(ctx, "success CreateAttachment")
+	return int(id), nil
+}
+
+// ReadAttachment returns an attachment of a visible comment, or an upload of the viewer which is not attached yet
+func (m *Model) ReadAttachment(ctx context.Context, id int, viewer string) (*Attachment, error) {
+	m.log.InfoContext(ctx, "start ReadAttachment")
+
+	sqlStatement := `
+		SELECT
+			a.id,
+			a.owner,
+			a.comment_id,
+			a.name,
+			a.content_type,
+			a.size,
+			a.storage_key,
+			a.thumbnail_key,
+			a.width,
+			a.height,
+			a.created_at
+		FROM main.attachment a
+		LEFT JOIN main.comment c ON a.comment_id = c.id
+		WHERE a.id = ? AND (c.status = 'visible' OR (a.comment_id IS NULL AND a.owner = ?));
+	`
+
+	a := new(Attachment)
+	if err := m.db.QueryRowContext(ctx, sqlStatement, id, viewer).Scan(
+		&a.ID,
+		&a.Owner,
+		&a.CommentID,
+		&a.Name,
+		&a.ContentType,
+		&a.Size,
+		&a.StorageKey,
+		&a.ThumbnailKey,
+		&a.Width,
+		&a.Height,
+		&a.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("attachment was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadAttachment", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadAttachment")
+	return a, nil
+}
+
+// ReadOrphanAttachments returns attachments older than ttl which do not belong to any comment,
+// either because they were never attached or because their comment was deleted
+func (m *Model) ReadOrphanAttachments(ctx context.Context, ttl time.Duration) ([]*Attachment, error) {
+	m.log.InfoContext(ctx, "start ReadOrphanAttachments")
+
+	sqlStatement := `
+		SELECT
+			a.id,
+			a.owner,
+			a.storage_key,
+			a.thumbnail_key,
+			a.created_at
+		FROM main.attachment a
+		WHERE a.comment_id IS NULL AND a.created_at < datetime('now', ?);
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, fmt.Sprintf("-%d seconds", int(ttl.Seconds())))
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadOrphanAttachments", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := make([]*Attachment, 0)
+	for rows.Next() {
+		a := new(Attachment)
+
+		if err := rows.Scan(
+			&a.ID,
+			&a.Owner,
+			&a.StorageKey,
+			&a.ThumbnailKey,
+			&a.CreatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadOrphanAttachments", "error", err)
+			return nil, err
+		}
+
+		as = append(as, a)
+	}
+
+	m.log.InfoContext(ctx, "success ReadOrphanAttachments")
+	return as, nil
+}
+
+// DeleteOrphanAttachment removes the record of an attachment, unless it was attached to a comment in the meantime
+func (m *Model) DeleteOrphanAttachment(ctx context.Context, id int) error {
+	m.log.InfoContext(ctx, "start DeleteOrphanAttachment")
+
+	sqlStatement := `
+		DELETE FROM attachment WHERE id = ? AND comment_id IS NULL;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, id)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteOrphanAttachment", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, attachment is in use")
+	}
+
+	m.log.InfoContext(ctx, "success DeleteOrphanAttachment")
+	return nil
+}
+
+// attachToComment links uploads of the owner which are not attached yet to the comment
+func (m *Model) attachToComment(ctx context.Context, tx *sql.Tx, owner *string, commentID int64, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if len(ids) > attachment.MaxPerComment {
+		return fmt.Errorf("comment can have at most %d attachments", attachment.MaxPerComment)
+	}
+
+	args := make([]any, 0, len(ids)+2)
+	args = append(args, commentID, owner)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		UPDATE attachment
+		SET comment_id = ?
+		WHERE owner = ? AND comment_id IS NULL AND id IN (%s);
+	`, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+
+	res, err := tx.ExecContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if int(n) != len(ids) {
+		return fmt.Errorf("no record was update, please verify attachment ids")
+	}
+
+	return nil
+}
+
+// readCommentAttachments returns attachments of the comments grouped by comment id
+func (m *Model) readCommentAttachments(ctx context.Context, commentIDs []int) (map[int][]*CommentAttachment, error) {
+	mAttachments := make(map[int][]*CommentAttachment)
+
+	for _, batch := range batches(commentIDs, batchSize) {
+		if err := m.readCommentAttachmentsBatch(ctx, batch, mAttachments); err != nil {
+			return nil, err
+		}
+	}
+
+	return mAttachments, nil
+}
+
+// readCommentAttachmentsBatch adds attachments of commentIDs to mAttachments
+func (m *Model) readCommentAttachmentsBatch(ctx context.Context, commentIDs []int, mAttachments map[int][]*CommentAttachment) error {
+	args := make([]any, len(commentIDs))
+	for i, id := range commentIDs {
+		args[i] = id
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT
+			a.id,
+			a.comment_id,
+			a.name,
+			a.content_type,
+			a.size,
+			a.width,
+			a.height,
+			a.thumbnail_key IS NOT NULL
+		FROM main.attachment a
+		WHERE a.comment_id IN (%s)
+		ORDER BY a.id ASC;
+	`, strings.TrimSuffix(strings.Repeat("?,", len(commentIDs)), ","))
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			commentID    int
+			hasThumbnail bool
+		)
+		a := new(CommentAttachment)
+
+		if err := rows.Scan(
+			&a.ID,
+			&commentID,
+			&a.Name,
+			&a.ContentType,
+			&a.Size,
+			&a.Width,
+			&a.Height,
+			&hasThumbnail,
+		); err != nil {
+			return err
+		}
+
+		a.Url = attachment.URL(a.ID)
+		a.ThumbnailUrl = attachment.ThumbnailURL(a.ID, hasThumbnail)
+		mAttachments[commentID] = append(mAttachments[commentID], a)
+	}
+
+	return rows.Err()
+}
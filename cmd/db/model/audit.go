@@ -0,0 +1,147 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type AuditLog struct {
+	ID         int
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	Reason     *string
+	RequestID  *string
+	IP         *string
+	CreatedAt  time.Time
+}
+
+type auditLogEntry struct {
+	Actor      *string
+	Action     constant.AuditAction
+	TargetType constant.AuditTargetType
+	TargetID   string
+	Reason     *string
+}
+
+// insertAuditLog records the entry within tx, so the audit log is written only when the audited change is.
+// Request id and client ip are taken from ctx when the api put them there.
+func (m *Model) insertAuditLog(ctx context.Context, tx *sql.Tx, entry *auditLogEntry) error {
+	sqlStatement := `
+		INSERT INTO audit_log (actor, action, target_type, target_id, reason, request_id, ip)
+		VALUES (?, ?, ?, ?, ?, ?, ?);
+	`
+
+	requestID, _ := ctx.Value(constant.RequestIDCtxKey).(string)
+	ip, _ := ctx.Value(constant.RealIPCtxKey).(string)
+
+	_, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		entry.Actor,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		entry.Reason,
+		nullString(requestID),
+		nullString(ip),
+	)
+
+	return err
+}
+
+type ReadAuditLogsInput struct {
+	Actor      *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
+
+func (m *Model) ReadAuditLogs(ctx context.Context, input *ReadAuditLogsInput) ([]*AuditLog, int, error) {
+	m.log.InfoContext(ctx, "start ReadAuditLogs")
+
+	filter := `
+		(? IS NULL OR a.actor = ?) AND
+		(? IS NULL OR a.action = ?) AND
+		(? IS NULL OR a.target_type = ?) AND
+		(? IS NULL OR a.target_id = ?) AND
+		(? IS NULL OR a.created_at >= ?) AND
+		(? IS NULL OR a.created_at < ?)
+	`
+	from, to := utcTime(input.From), utcTime(input.To)
+	filterArgs := []any{
+		input.Actor, input.Actor,
+		input.Action, input.Action,
+		input.TargetType, input.TargetType,
+		input.TargetID, input.TargetID,
+		from, from,
+		to, to,
+	}
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.audit_log a WHERE `+filter,
+		filterArgs...,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadAuditLogs", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT
+			a.id,
+			a.actor,
+			a.action,
+			a.target_type,
+			a.target_id,
+			a.reason,
+			a.request_id,
+			a.ip,
+			a.created_at
+		FROM main.audit_log a
+		WHERE ` + filter + `
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, append(filterArgs, input.Limit, input.Offset)...)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadAuditLogs", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	logs := make([]*AuditLog, 0)
+	for rows.Next() {
+		a := new(AuditLog)
+
+		if err = rows.Scan(
+			&a.ID,
+			&a.Actor,
+			&a.Action,
+			&a.TargetType,
+			&a.TargetID,
+			&a.Reason,
+			&a.RequestID,
+			&a.IP,
+			&a.CreatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadAuditLogs", "error", err)
+			return nil, 0, err
+		}
+
+		logs = append(logs, a)
+	}
+
+	m.log.InfoContext(ctx, "success ReadAuditLogs")
+	return logs, total, nil
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/avatar"
+)
+
+type Avatar struct {
+	ContentType string
+	Data        []byte
+	ETag        string
+	UpdatedAt   time.Time
+}
+
+func (m *Model) ReadAvatar(ctx context.Context, username string) (*Avatar, error) {
+	m.log.InfoContext(ctx, "start ReadAvatar")
+
+	sqlStatement := `
+		SELECT
+			a.content_type,
+			a.data,
+			a.etag,
+			a.updated_at
+		FROM main.avatar a
+		WHERE a.username = ?;
+	`
+
+	a := new(Avatar)
+	if err := m.db.QueryRowContext(ctx, sqlStatement, username).Scan(
+		&a.ContentType,
+		&a.Data,
+		&a.ETag,
+		&a.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("avatar was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadAvatar", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadAvatar")
+	return a, nil
+}
+
+type UpsertAvatarInput struct {
+	Username    *string
+	ContentType string
+	Data        []byte
+}
+
+func (m *Model) UpsertAvatar(ctx context.Context, input *UpsertAvatarInput) error {
+	m.log.InfoContext(ctx, "start UpsertAvatar")
+
+	if err := upsertAvatar(ctx, m.db, input); err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertAvatar", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success UpsertAvatar")
+	return nil
+}
+
+func upsertAvatar(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}, input *UpsertAvatarInput) error {
+	sum := sha256.Sum256(input.Data)
+	etag := hex.EncodeToString(sum[:8])
+
+	sqlStatement := `
+		INSERT INTO avatar (username, content_type, data, etag)
+		SELECT u.username, ?, ?, ?
+		FROM user_ u
+		WHERE u.username = ?
+		ON CONFLICT(username)
+			DO UPDATE SET content_type = excluded.content_type, data = excluded.data, etag = excluded.etag, updated_at = CURRENT_TIMESTAMP;
+	`
+
+	res, err := db.ExecContext(ctx, sqlStatement, input.ContentType, input.Data, etag, input.Username)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify user")
+	}
+
+	return nil
+}
+
+// migrateAvatars moves avatars stored as data uris in user_.avatar_url into the avatar table
+func (m *Model) migrateAvatars(ctx context.Context) error {
+	m.log.InfoContext(ctx, "start migrateAvatars")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT username, avatar_url FROM user_ WHERE avatar_url IS NOT NULL;`)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+		return err
+	}
+
+	uris := make(map[string]string)
+	for rows.Next() {
+		var username, uri string
+		if err := rows.Scan(&username, &uri); err != nil {
+			rows.Close()
+			m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+			return err
+		}
+		uris[username] = uri
+	}
+	rows.Close()
+
+	for username, uri := range uris {
+		data, contentType, err := avatar.ParseDataURI(uri)
+		if err != nil {
+			// NOTE: broken avatars are dropped, users can upload a new one
+			m.log.WarnContext(ctx, "skip migrateAvatars", "username", username, "error", err)
+		} else if err := upsertAvatar(ctx, tx, &UpsertAvatarInput{
+			Username:    &username,
+			ContentType: contentType,
+			Data:        data,
+		}); err != nil {
+			m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE user_ SET avatar_url = NULL WHERE username = ?;`, username); err != nil {
+			m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail migrateAvatars", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success migrateAvatars", "migrated", len(uris))
+	return nil
+}
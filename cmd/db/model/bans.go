@@ -0,0 +1,155 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type BanUserInput struct {
+	Username *string
+	Status   constant.BanStatus
+	Until    *time.Time
+	Reason   *string
+	Actor    *string
+	// AnyRole allows to sanction moderators and admins as well, otherwise only plain users can be sanctioned
+	AnyRole bool
+}
+
+func (m *Model) BanUser(ctx context.Context, input *BanUserInput) error {
+	m.log.InfoContext(ctx, "start BanUser")
+
+	switch {
+	case input.Status == constant.BanStatusSuspended && input.Until == nil:
+		return fmt.Errorf("until is required for a suspension")
+	case input.Status == constant.BanStatusBanned && input.Until != nil:
+		return fmt.Errorf("a permanent ban cannot have until, use a suspension instead")
+	case input.Until != nil && !input.Until.After(time.Now()):
+		return fmt.Errorf("until must be in the future")
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail BanUser", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		UPDATE user_
+		SET ban_status = ?, ban_until = ?, ban_reason = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE username = ? AND username != ? AND (role = 'user' OR ?);
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Status,
+		utcTime(input.Until),
+		input.Reason,
+		input.Username,
+		input.Actor,
+		input.AnyRole,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail BanUser", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify username, you cannot sanction yourself or staff members")
+	}
+
+	reason := string(input.Status)
+	if input.Until != nil {
+		reason = fmt.Sprintf("%s until %s", reason, *utcTime(input.Until))
+	}
+	if input.Reason != nil {
+		reason = fmt.Sprintf("%s: %s", reason, *input.Reason)
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionUserBan,
+		TargetType: constant.AuditTargetUser,
+		TargetID:   *input.Username,
+		Reason:     &reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail BanUser", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail BanUser", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success BanUser")
+	return nil
+}
+
+type UnbanUserInput struct {
+	Username *string
+	Reason   *string
+	Actor    *string
+	AnyRole  bool
+}
+
+func (m *Model) UnbanUser(ctx context.Context, input *UnbanUserInput) error {
+	m.log.InfoContext(ctx, "start UnbanUser")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UnbanUser", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		UPDATE user_
+		SET ban_status = NULL, ban_until = NULL, ban_reason = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE username = ? AND (role = 'user' OR ?) AND EXISTS (
+			SELECT * FROM active_ban ab WHERE ab.username = user_.username
+		);
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Username,
+		input.AnyRole,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UnbanUser", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify username or that the user is banned")
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionUserUnban,
+		TargetType: constant.AuditTargetUser,
+		TargetID:   *input.Username,
+		Reason:     input.Reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail UnbanUser", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail UnbanUser", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success UnbanUser")
+	return nil
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type CreateUserBlockInput struct {
+	Blocker *string
+	Blocked string
+	Kind    constant.BlockKind
+}
+
+func (m *Model) CreateUserBlock(ctx context.Context, input *CreateUserBlockInput) error {
+	m.log.InfoContext(ctx, "start CreateUserBlock")
+
+	if input.Blocker != nil && *input.Blocker == input.Blocked {
+		return fmt.Errorf("you cannot %s yourself", input.Kind)
+	}
+
+	sqlStatement := `
+		INSERT INTO user_block (blocker, blocked, kind)
+		SELECT ?, u.username, ?
+		FROM user_ u
+		WHERE u.username = ?
+		ON CONFLICT(blocker, blocked, kind) DO NOTHING;
+	`
+
+	res, err := m.db.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Blocker,
+		input.Kind,
+		input.Blocked,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateUserBlock", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify username or that you have not done it already")
+	}
+
+	m.log.InfoContext(ctx, "success CreateUserBlock")
+	return nil
+}
+
+type DeleteUserBlockInput struct {
+	Blocker *string
+	Blocked string
+	Kind    constant.BlockKind
+}
+
+func (m *Model) DeleteUserBlock(ctx context.Context, input *DeleteUserBlockInput) error {
+	m.log.InfoContext(ctx, "start DeleteUserBlock")
+
+	sqlStatement := `
+		DELETE FROM user_block
+		WHERE blocker = ? AND blocked = ? AND kind = ?;
+	`
+
+	res, err := m.db.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Blocker,
+		input.Blocked,
+		input.Kind,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteUserBlock", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please verify username")
+	}
+
+	m.log.InfoContext(ctx, "success DeleteUserBlock")
+	return nil
+}
+
+// isBlockedByCommentAuthor tells whether the author of comment id or addressee has blocked username
+func (m *Model) isBlockedByCommentAuthor(ctx context.Context, username *string, id *int, addressee *string) (bool, error) {
+	sqlStatement := `
+		SELECT EXISTS (
+			SELECT *
+			FROM main.user_block b
+			WHERE b.blocked = ? AND b.kind = 'block' AND (
+				b.blocker = (SELECT c.author FROM main.comment c WHERE c.id = ?) OR b.blocker = ?
+			)
+		);
+	`
+
+	var blocked bool
+	if err := m.db.QueryRowContext(ctx, sqlStatement, username, id, addressee).Scan(&blocked); err != nil {
+		return false, err
+	}
+
+	return blocked, nil
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/avatar"
+)
+
+type BookmarkInput struct {
+	Username  *string
+	CommentID *int
+}
+
+// CreateBookmark saves the comment for the user to read later
+func (m *Model) CreateBookmark(ctx context.Context, input *BookmarkInput) error {
+	m.log.InfoContext(ctx, "start CreateBookmark")
+
+	sqlStatement := `
+		INSERT INTO bookmark (username, comment_id)
+		SELECT u.username, c.id
+		FROM comment c
+		JOIN user_ u ON u.username = ?
+		WHERE c.id = ? AND c.status = 'visible'
+		ON CONFLICT(username, comment_id) DO NOTHING;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.Username, input.CommentID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateBookmark", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify comment id or that you have not done it already")
+	}
+
+	m.log.InfoContext(ctx, "success CreateBookmark")
+	return nil
+}
+
+// DeleteBookmark removes the comment from the saved ones of the user
+func (m *Model) DeleteBookmark(ctx context.Context, input *BookmarkInput) error {
+	m.log.InfoContext(ctx, "start DeleteBookmark")
+
+	sqlStatement := `
+		DELETE FROM bookmark WHERE username = ? AND comment_id = ?;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.Username, input.CommentID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteBookmark", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please verify you saved the comment")
+	}
+
+	m.log.InfoContext(ctx, "success DeleteBookmark")
+	return nil
+}
+
+type Bookmark struct {
+	ID           int
+	Content      string
+	ContentHtml  string
+	Author       string
+	AvatarUrl    string
+	ParentID     *int
+	CreatedAt    time.Time
+	BookmarkedAt time.Time
+}
+
+type ReadBookmarksInput struct {
+	Username string
+	Limit    int
+	Offset   int
+}
+
+// bookmarkFilter keeps bookmarks of the b.username user on comments they can still see in the thread
+const bookmarkFilter = `
+	b.username = ? AND c.status = 'visible' AND
+	(c.parent_id IS NULL OR EXISTS (SELECT * FROM main.comment pc WHERE pc.id = c.parent_id AND pc.status = 'visible')) AND
+	(c.author = b.username OR NOT EXISTS (
+		SELECT * FROM main.active_ban ab WHERE ab.username = c.author AND ab.status = 'shadow_banned'
+	))
+`
+
+// ReadBookmarks lists comments the user saved, latest saved first
+func (m *Model) ReadBookmarks(ctx context.Context, input *ReadBookmarksInput) ([]*Bookmark, int, error) {
+	m.log.InfoContext(ctx, "start ReadBookmarks")
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.bookmark b JOIN main.comment c ON b.comment_id = c.id WHERE `+bookmarkFilter,
+		input.Username,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadBookmarks", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT c.id, c.content, c.author, a.etag, c.parent_id, c.created_at, b.created_at
+		FROM main.bookmark b
+		JOIN main.comment c ON b.comment_id = c.id
+		LEFT JOIN main.avatar a ON c.author = a.username
+		WHERE ` + bookmarkFilter + `
+		ORDER BY b.created_at DESC, c.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, input.Username, input.Limit, input.Offset)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadBookmarks", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	bookmarks := make([]*Bookmark, 0)
+	for rows.Next() {
+		b := new(Bookmark)
+		var avatarEtag *string
+
+		if err := rows.Scan(
+			&b.ID,
+			&b.Content,
+			&b.Author,
+			&avatarEtag,
+			&b.ParentID,
+			&b.CreatedAt,
+			&b.BookmarkedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadBookmarks", "error", err)
+			return nil, 0, err
+		}
+
+		// NOTE: without their threads references would point nowhere, so they stay text
+		b.ContentHtml = m.md.Render(b.Content, nil)
+		b.AvatarUrl = avatar.URL(b.Author, avatarEtag)
+		bookmarks = append(bookmarks, b)
+	}
+
+	m.log.InfoContext(ctx, "success ReadBookmarks")
+	return bookmarks, total, nil
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+// readCollapseScore returns the net score below which comments are collapsed for the viewer
+func (m *Model) readCollapseScore(ctx context.Context, username string) (int, error) {
+	sqlStatement := `
+		SELECT u.collapse_score FROM user_ u WHERE u.username = ?;
+	`
+
+	var score *int
+	if err := m.db.QueryRowContext(ctx, sqlStatement, username).Scan(&score); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	if score == nil {
+		return m.conf.CollapseScore, nil
+	}
+
+	return *score, nil
+}
+
+// collapseReason tells why the comment is returned collapsed, low scores are ignored when collapseScore is nil
+func (c *DBComment) collapseReason(collapseScore *int) constant.CollapseReason {
+	switch {
+	case c.Blocked:
+		return constant.CollapseReasonBlocked
+	case collapseScore != nil && !c.IsMine && c.Likes < *collapseScore:
+		return constant.CollapseReasonLowScore
+	default:
+		return ""
+	}
+}
+
+// collapsedContent returns the content to show for the comment, low score ones are cut to an excerpt
+func collapsedContent(content string, reason constant.CollapseReason) string {
+	if reason == constant.CollapseReasonLowScore {
+		return excerpt(content)
+	}
+
+	return content
+}
@@ -2,75 +2,201 @@ package model
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/avatar"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
 )
 
 type DBComment struct {
-	ID        int
-	Content   string
-	Author    string
-	AvatarUrl string
-	Likes     int
-	Duration  string
-	IsMine    bool
-	MyRate    int
-	ParentID  *int
-	Addressee *string
+	ID            int
+	Content       string
+	Author        string
+	AvatarEtag    *string
+	AuthorKarma   int
+	Likes         int
+	Duration      string
+	IsMine        bool
+	MyRate        int
+	IsBookmarked  bool
+	IsNew         bool
+	SpamScore     *float64
+	Blocked       bool
+	ParentID      *int
+	Addressee     *string
+	QuotedID      *int
+	QuotedAuthor  *string
+	QuotedContent *string
+	Pinned        bool
+	Featured      bool
+	Locked        bool
+	AcceptedID    *int
 }
 
 type Reply struct {
-	ID        int
-	Content   string
-	Author    string
-	AvatarUrl string
-	Likes     int
-	Duration  string
-	IsMine    bool
-	MyRate    int
-	Addressee string
+	ID              int
+	Content         string
+	ContentHtml     string
+	Author          string
+	AvatarUrl       string
+	AuthorKarma     int
+	Likes           int
+	Duration        string
+	IsMine          bool
+	MyRate          int
+	IsBookmarked    bool
+	IsNew           bool
+	SpamScore       *float64
+	Addressee       string
+	Collapsed       bool
+	CollapsedReason constant.CollapseReason
+	Attachments     []*CommentAttachment
+	Quote           *Quote
+	QuotedBy        []int
+	Accepted        bool
 }
 
 type Comment struct {
-	ID        int
-	Content   string
-	Author    string
-	AvatarUrl string
-	Likes     int
-	Duration  string
-	IsMine    bool
-	MyRate    int
-	Replies   []*Reply
+	ID              int
+	Content         string
+	ContentHtml     string
+	Author          string
+	AvatarUrl       string
+	AuthorKarma     int
+	Likes           int
+	Duration        string
+	IsMine          bool
+	MyRate          int
+	IsBookmarked    bool
+	IsNew           bool
+	SpamScore       *float64
+	Collapsed       bool
+	CollapsedReason constant.CollapseReason
+	Attachments     []*CommentAttachment
+	Quote           *Quote
+	QuotedBy        []int
+	Pinned          bool
+	Featured        bool
+	Locked          bool
+	Poll            *Poll
+	// NewCount is the number of replies which are new to the viewer
+	NewCount int
+	Replies  []*Reply
 }
 
-func (m *Model) ReadComments(ctx context.Context, username string) ([]*Comment, error) {
+type ReadCommentsInput struct {
+	User string
+	// Status keeps top-level comments of the status only, all of them when empty
+	Status constant.ThreadStatus
+}
+
+func (m *Model) ReadComments(ctx context.Context, input *ReadCommentsInput) ([]*Comment, error) {
 	m.log.InfoContext(ctx, "start ReadComments")
 
-	parentIds, err := m.getParentCommentsId(ctx, username)
+	parentIds, err := m.getParentCommentsId(ctx, input.User, input.Status)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail ReadComments", "error", err)
 		return nil, err
 	}
 
-	comments, err := m.getComments(ctx, username, parentIds)
+	collapseScore, err := m.readCollapseScore(ctx, input.User)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail ReadComments", "error", err)
 		return nil, err
 	}
 
+	readAt := time.Now()
+
+	comments, err := m.getComments(ctx, input.User, parentIds, &collapseScore)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadComments", "error", err)
+		return nil, err
+	}
+
+	// NOTE: isNew of this response is relative to the previous visit
+	if err := m.markThreadsSeen(ctx, input.User, parentIds, readAt); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadComments", "error", err)
+		return nil, err
+	}
+
 	m.log.InfoContext(ctx, "success ReadComments")
 	return comments, nil
 }
 
-func (m *Model) getParentCommentsId(ctx context.Context, username string) ([]*int, error) {
+type ReadCommentInput struct {
+	ID   *int
+	User string
+}
+
+// ReadComment returns the thread of the comment with nothing collapsed for its low score
+func (m *Model) ReadComment(ctx context.Context, input *ReadCommentInput) (*Comment, error) {
+	m.log.InfoContext(ctx, "start ReadComment")
+
+	sqlStatement := `
+		SELECT COALESCE(c.parent_id, c.id) FROM main.comment c WHERE c.id = ? AND c.status = 'visible';
+	`
+
+	threadID := new(int)
+	if err := m.db.QueryRowContext(ctx, sqlStatement, input.ID).Scan(threadID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("comment was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadComment", "error", err)
+		return nil, err
+	}
+
+	comments, err := m.getComments(ctx, input.User, []*int{threadID}, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadComment", "error", err)
+		return nil, err
+	}
+
+	// NOTE: muted, shadow-banned or hidden parents leave an empty thread behind
+	if len(comments) == 0 || !comments[0].contains(*input.ID) {
+		err := fmt.Errorf("comment was not found")
+		m.log.ErrorContext(ctx, "fail ReadComment", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadComment")
+	return comments[0], nil
+}
+
+// contains checks whether id is of the comment or one of its replies
+func (c *Comment) contains(id int) bool {
+	if c.ID == id {
+		return true
+	}
+
+	for _, r := range c.Replies {
+		if r.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Model) getParentCommentsId(ctx context.Context, username string, status constant.ThreadStatus) ([]*int, error) {
 	m.log.InfoContext(ctx, "start getParentCommentsId")
 
 	sqlStatement := `
 		SELECT c.OID
 		FROM main.comment c
-		WHERE c.parent_id IS NULL
+		WHERE c.parent_id IS NULL AND c.status = 'visible' AND NOT EXISTS (
+			SELECT * FROM main.user_block b WHERE b.blocker = ? AND b.blocked = c.author AND b.kind = 'mute'
+		) AND (c.author = ? OR NOT EXISTS (
+			SELECT * FROM main.active_ban ab WHERE ab.username = c.author AND ab.status = 'shadow_banned'
+		)) AND ` + threadStatusFilter(status) + `
+		ORDER BY c.pinned DESC, c.OID ASC
 	`
 
-	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	rows, err := m.db.QueryContext(ctx, sqlStatement, username, username)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail getParentCommentsId", "error", err)
 		return nil, err
@@ -95,7 +221,8 @@ func (m *Model) getParentCommentsId(ctx context.Context, username string) ([]*in
 	return ids, nil
 }
 
-func (m *Model) getComments(ctx context.Context, username string, pIds []*int) ([]*Comment, error) {
+// getComments builds the threads of pIds, comments scoring below collapseScore are collapsed unless it is nil
+func (m *Model) getComments(ctx context.Context, username string, pIds []*int, collapseScore *int) ([]*Comment, error) {
 	m.log.InfoContext(ctx, "start getComments")
 
 	sqlStatement := `
@@ -104,6 +231,14 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 			c.content as content,
 			c.author as author,
 			c.addressee as addressee,
+			c.spam_score as spam_score,
+			qc.id as quoted_id,
+			qc.author as quoted_author,
+			qc.content as quoted_content,
+			c.pinned as pinned,
+			c.featured as featured,
+			c.locked as locked,
+			c.accepted_reply_id as accepted_reply_id,
 			CASE
 				WHEN (strftime('%Y', 'now') - strftime('%Y', c.created_at)) > 0
 					THEN 'More than ' || (strftime('%Y', 'now') - strftime('%Y', c.created_at)) || ' year(s) ago'
@@ -119,8 +254,19 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 					THEN 'More than ' || (strftime('%S', 'now') - strftime('%S', c.created_at)) || ' second(s) ago'
 				ELSE 'now'
 			END AS duration,
-			u.avatar_url as avatar_url,
+			a.etag as avatar_etag,
+			k.points as author_karma,
+			k.updated_at as author_karma_updated_at,
 			u.username == ? as is_mine,
+			EXISTS (
+				SELECT * FROM main.user_block b WHERE b.blocker = ? AND b.blocked = c.author AND b.kind = 'block'
+			) as is_blocked,
+			EXISTS (
+				SELECT * FROM main.bookmark bm WHERE bm.username = ? AND bm.comment_id = c.id
+			) as is_bookmarked,
+			? != '' AND c.author != ? AND c.created_at > COALESCE((
+				SELECT v.seen_at FROM main.thread_visit v WHERE v.username = ? AND v.comment_id = COALESCE(c.parent_id, c.id)
+			), '') as is_new,
 			pc.OID as parent_id,
 			CASE
 				WHEN l.count is NULL THEN 0
@@ -132,7 +278,14 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 			END AS my_rate
 		FROM main.comment c
 		LEFT JOIN main.user_ u ON c.author = u.username
+		LEFT JOIN main.avatar a ON c.author = a.username
+		LEFT JOIN main.karma k ON c.author = k.username
 		LEFT JOIN main.comment pc ON c.parent_id = pc.OID
+		LEFT JOIN main.comment qc ON c.quoted_comment_id = qc.id AND qc.status = 'visible' AND NOT EXISTS (
+			SELECT * FROM main.user_block b WHERE b.blocker = ? AND b.blocked = qc.author AND b.kind = 'mute'
+		) AND (qc.author = ? OR NOT EXISTS (
+			SELECT * FROM main.active_ban ab WHERE ab.username = qc.author AND ab.status = 'shadow_banned'
+		))
 		LEFT JOIN
 			(
 				SELECT
@@ -140,6 +293,9 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 					SUM(rate) as count
 				FROM
 					like_
+				WHERE author = ? OR NOT EXISTS (
+					SELECT * FROM main.active_ban ab WHERE ab.username = like_.author AND ab.status = 'shadow_banned'
+				)
 				GROUP BY
 					comment_id
 			) as l
@@ -149,41 +305,50 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 			like_ l2
 			ON
 				c.OID == l2.comment_id AND l2.author == ?
-		ORDER BY c.created_at DESC;
-	`
-	rows, err := m.db.QueryContext(ctx, sqlStatement, username, username)
+		WHERE c.status = 'visible' AND NOT EXISTS (
+			SELECT * FROM main.user_block b WHERE b.blocker = ? AND b.blocked = c.author AND b.kind = 'mute'
+		) AND (c.author = ? OR NOT EXISTS (
+			SELECT * FROM main.active_ban ab WHERE ab.username = c.author AND ab.status = 'shadow_banned'
+		))
+		AND COALESCE(c.parent_id, c.id) IN (`
+
+	// NOTE: get comments of the threads from db, every thread is read by a single query
+	dbComments := make([]*DBComment, 0)
+	for _, batch := range batches(pIds, batchSize) {
+		batchComments, err := m.readThreadComments(ctx, sqlStatement, username, batch)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail getComments", "error", err)
+			return nil, err
+		}
+		dbComments = append(dbComments, batchComments...)
+	}
+
+	commentIDs := make([]int, len(dbComments))
+	for i, c := range dbComments {
+		commentIDs[i] = c.ID
+	}
+
+	mAttachments, err := m.readCommentAttachments(ctx, commentIDs)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail getComments", "error", err)
 		return nil, err
 	}
-	defer rows.Close()
-
-	// NOTE: get all comments from db
-	dbComments := make([]*DBComment, 0)
-	for rows.Next() {
-		c := new(DBComment)
 
-		if err = rows.Scan(
-			&c.ID,
-			&c.Content,
-			&c.Author,
-			&c.Addressee,
-			&c.Duration,
-			&c.AvatarUrl,
-			&c.IsMine,
-			&c.ParentID,
-			&c.Likes,
-			&c.MyRate,
-		); err != nil {
-			m.log.ErrorContext(ctx, "fail getComments", "error", err)
-			return nil, err
-		}
+	refs, err := m.readCommentReferences(ctx, commentIDs)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail getComments", "error", err)
+		return nil, err
+	}
 
-		dbComments = append(dbComments, c)
+	mPolls, err := m.readCommentPolls(ctx, commentIDs, username)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail getComments", "error", err)
+		return nil, err
 	}
 
 	// NOTE: map parent ids to value
 	mIds := make(map[int]*Comment, len(pIds))
+	mAccepted := make(map[int]int, len(pIds))
 	for _, id := range pIds {
 		if id != nil {
 			mIds[*id] = new(Comment)
@@ -192,16 +357,37 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 
 	for _, c := range dbComments {
 		if _, ok := mIds[c.ID]; ok {
+			reason := c.collapseReason(collapseScore)
+			content := collapsedContent(c.Content, reason)
 			mIds[c.ID] = &Comment{
-				ID:        c.ID,
-				Content:   c.Content,
-				Author:    c.Author,
-				AvatarUrl: c.AvatarUrl,
-				Likes:     c.Likes,
-				Duration:  c.Duration,
-				IsMine:    c.IsMine,
-				MyRate:    c.MyRate,
-				Replies:   make([]*Reply, 0),
+				ID:           c.ID,
+				Content:      content,
+				ContentHtml:  m.md.Render(content, refs.refs[c.ID]),
+				Author:       c.Author,
+				AvatarUrl:    avatar.URL(c.Author, c.AvatarEtag),
+				AuthorKarma:  c.AuthorKarma,
+				Likes:        c.Likes,
+				Duration:     c.Duration,
+				IsMine:       c.IsMine,
+				MyRate:       c.MyRate,
+				IsBookmarked: c.IsBookmarked,
+				IsNew:        c.IsNew,
+				SpamScore:    c.SpamScore,
+				Attachments:  attachmentsOrEmpty(mAttachments[c.ID]),
+				Quote:        c.quote(),
+				QuotedBy:     idsOrEmpty(refs.quotedBy[c.ID]),
+				Pinned:       c.Pinned,
+				Featured:     c.Featured,
+				Locked:       c.Locked,
+				Poll:         mPolls[c.ID],
+				Replies:      make([]*Reply, 0),
+			}
+			if reason != "" {
+				mIds[c.ID].Collapsed = true
+				mIds[c.ID].CollapsedReason = reason
+			}
+			if c.AcceptedID != nil {
+				mAccepted[c.ID] = *c.AcceptedID
 			}
 		}
 	}
@@ -210,20 +396,43 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 	for _, c := range dbComments {
 		if c != nil && c.ParentID != nil {
 			if _, ok := mIds[*c.ParentID]; ok {
+				reason := c.collapseReason(collapseScore)
+				content := collapsedContent(c.Content, reason)
 				r := &Reply{
-					ID:        c.ID,
-					Content:   c.Content,
-					Author:    c.Author,
-					AvatarUrl: c.AvatarUrl,
-					Likes:     c.Likes,
-					Duration:  c.Duration,
-					IsMine:    c.IsMine,
-					MyRate:    c.MyRate,
+					ID:           c.ID,
+					Content:      content,
+					ContentHtml:  m.md.Render(content, refs.refs[c.ID]),
+					Author:       c.Author,
+					AvatarUrl:    avatar.URL(c.Author, c.AvatarEtag),
+					AuthorKarma:  c.AuthorKarma,
+					Likes:        c.Likes,
+					Duration:     c.Duration,
+					IsMine:       c.IsMine,
+					MyRate:       c.MyRate,
+					IsBookmarked: c.IsBookmarked,
+					IsNew:        c.IsNew,
+					SpamScore:    c.SpamScore,
+					Attachments:  attachmentsOrEmpty(mAttachments[c.ID]),
+					Quote:        c.quote(),
+					QuotedBy:     idsOrEmpty(refs.quotedBy[c.ID]),
+					Accepted:     mAccepted[*c.ParentID] == c.ID,
 				}
 				if c.Addressee != nil {
 					r.Addressee = *c.Addressee
 				}
-				mIds[*c.ParentID].Replies = append(mIds[*c.ParentID].Replies, r)
+				if reason != "" {
+					r.Collapsed = true
+					r.CollapsedReason = reason
+				}
+				if r.IsNew {
+					mIds[*c.ParentID].NewCount++
+				}
+				// NOTE: the accepted answer goes first
+				if r.Accepted {
+					mIds[*c.ParentID].Replies = append([]*Reply{r}, mIds[*c.ParentID].Replies...)
+				} else {
+					mIds[*c.ParentID].Replies = append(mIds[*c.ParentID].Replies, r)
+				}
 			}
 		}
 	}
@@ -239,33 +448,195 @@ func (m *Model) getComments(ctx context.Context, username string, pIds []*int) (
 	return comments, nil
 }
 
+// readThreadComments runs sqlStatement of getComments, which binds username 12 times, for the threads of pIds
+func (m *Model) readThreadComments(ctx context.Context, sqlStatement string, username string, pIds []*int) ([]*DBComment, error) {
+	args := make([]any, 0, 12+len(pIds))
+	for i := 0; i < 12; i++ {
+		args = append(args, username)
+	}
+	for _, id := range pIds {
+		args = append(args, id)
+	}
+
+	sqlStatement += strings.TrimSuffix(strings.Repeat("?,", len(pIds)), ",") + `)
+		ORDER BY c.created_at DESC;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbComments := make([]*DBComment, 0)
+	for rows.Next() {
+		c := new(DBComment)
+		var karmaPoints *float64
+		var karmaUpdatedAt *time.Time
+
+		if err = rows.Scan(
+			&c.ID,
+			&c.Content,
+			&c.Author,
+			&c.Addressee,
+			&c.SpamScore,
+			&c.QuotedID,
+			&c.QuotedAuthor,
+			&c.QuotedContent,
+			&c.Pinned,
+			&c.Featured,
+			&c.Locked,
+			&c.AcceptedID,
+			&c.Duration,
+			&c.AvatarEtag,
+			&karmaPoints,
+			&karmaUpdatedAt,
+			&c.IsMine,
+			&c.Blocked,
+			&c.IsBookmarked,
+			&c.IsNew,
+			&c.ParentID,
+			&c.Likes,
+			&c.MyRate,
+		); err != nil {
+			return nil, err
+		}
+
+		c.AuthorKarma = m.karmaOf(karmaPoints, karmaUpdatedAt)
+		dbComments = append(dbComments, c)
+	}
+
+	return dbComments, rows.Err()
+}
+
+func (c *DBComment) quote() *Quote {
+	if c.QuotedID == nil {
+		return nil
+	}
+
+	return &Quote{
+		ID:      *c.QuotedID,
+		Author:  *c.QuotedAuthor,
+		Excerpt: excerpt(*c.QuotedContent),
+	}
+}
+
+func idsOrEmpty(ids []int) []int {
+	if ids == nil {
+		return make([]int, 0)
+	}
+
+	return ids
+}
+
+func attachmentsOrEmpty(as []*CommentAttachment) []*CommentAttachment {
+	if as == nil {
+		return make([]*CommentAttachment, 0)
+	}
+
+	return as
+}
+
 type CreateCommentInput struct {
-	Author    *string
-	Content   string
-	ParentID  *int
-	Addressee *string
+	Author          *string
+	Content         string
+	ParentID        *int
+	Addressee       *string
+	QuotedCommentID *int
+	Attachments     []int
+	Poll            *CreatePollInput
+	// PublishAt schedules the comment, it is published right away when nil
+	PublishAt *time.Time
 }
 
 func (m *Model) CreateComment(ctx context.Context, input *CreateCommentInput) error {
 	m.log.InfoContext(ctx, "start CreateComment")
 
+	filtered, err := m.applyFilterRules(ctx, input.Content)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	spamScore, err := m.scoreSpam(ctx, nil, input.Author, filtered.Content)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	if input.ParentID != nil {
+		blocked, err := m.isBlockedByCommentAuthor(ctx, input.Author, input.ParentID, input.Addressee)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+			return err
+		}
+		if blocked {
+			return fmt.Errorf("you cannot reply to this user")
+		}
+	}
+
+	if input.ParentID != nil {
+		locked, err := m.isThreadLocked(ctx, input.ParentID)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+			return err
+		}
+		if locked {
+			return fmt.Errorf("this thread is locked, replies are not allowed")
+		}
+	}
+
+	if input.Poll != nil && input.ParentID != nil {
+		return fmt.Errorf("polls are only allowed on top-level comments")
+	}
+
+	if input.QuotedCommentID != nil {
+		quotable, err := m.isQuotable(ctx, input.QuotedCommentID, input.ParentID, input.Author)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+			return err
+		}
+		if !quotable {
+			return fmt.Errorf("quoted comment was not found in this thread")
+		}
+	}
+
+	// NOTE: quarantined comments wait for a moderator instead of their publish time
+	status := constant.CommentStatusVisible
+	if filtered.Moderate || (m.conf.SpamThreshold > 0 && spamScore >= m.conf.SpamThreshold) {
+		status = constant.CommentStatusHidden
+	} else if input.PublishAt != nil {
+		status = constant.CommentStatusScheduled
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
 	sqlStatement := `
-		INSERT INTO comment (author, content, parent_id, addressee)
-		SELECT ?, ?, ?, ?
+		INSERT INTO comment (author, content, parent_id, addressee, status, spam_score, quoted_comment_id, publish_at)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?
 		WHERE ? IS NULL OR (
 		    ? IS NOT NULL AND EXISTS (
-				SELECT * FROM comment c WHERE c.id = ? AND c.parent_id IS NULL
+				SELECT * FROM comment c WHERE c.id = ? AND c.parent_id IS NULL AND c.status = 'visible'
 			)
 		);
 	`
 
-	res, err := m.db.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		sqlStatement,
 		input.Author,
-		input.Content,
+		filtered.Content,
 		input.ParentID,
 		input.Addressee,
+		status,
+		spamScore,
+		input.QuotedCommentID,
+		utcTime(input.PublishAt),
 		input.ParentID,
 		input.ParentID,
 		input.ParentID,
@@ -281,31 +652,111 @@ func (m *Model) CreateComment(ctx context.Context, input *CreateCommentInput) er
 		return fmt.Errorf("no record was inserted, please verify parent id")
 	}
 
+	id, err := res.LastInsertId()
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	if err := m.attachToComment(ctx, tx, input.Author, id, input.Attachments); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	if err := m.saveCommentReferences(ctx, tx, id, filtered.Content); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	if input.Poll != nil {
+		if err := createPoll(ctx, tx, id, input.Poll); err != nil {
+			m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+			return err
+		}
+	}
+
+	if err := subscribe(ctx, tx, id); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	// NOTE: quarantined replies are announced once a moderator approves them
+	if err := notifySubscribers(ctx, tx, id); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateComment", "error", err)
+		return err
+	}
+
 	m.log.InfoContext(ctx, "success CreateComment")
 	return nil
 }
 
 type UpdateCommentInput struct {
-	ID      *int
-	Author  *string
-	Content string
+	ID        *int
+	Author    *string
+	AnyAuthor bool
+	Content   string
 }
 
 func (m *Model) UpdateComment(ctx context.Context, input *UpdateCommentInput) error {
 	m.log.InfoContext(ctx, "start UpdateComment")
 
+	filtered, err := m.applyFilterRules(ctx, input.Content)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
+	// NOTE: the comment is scored as posted by its author, whoever edits it
+	var author string
+	if err := m.db.QueryRowContext(ctx, `SELECT c.author FROM main.comment c WHERE c.id = ?;`, input.ID).Scan(&author); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("no record was update, please verify request")
+		}
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
+	spamScore, err := m.scoreSpam(ctx, input.ID, &author, filtered.Content)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
+	// NOTE: an edit can turn a comment into spam, it is quarantined like a new one unless an admin edits it
+	quarantine := filtered.Moderate ||
+		(!input.AnyAuthor && m.conf.SpamThreshold > 0 && spamScore >= m.conf.SpamThreshold)
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
 	sqlStatement := `
 		UPDATE comment
-		SET content = ?
-		WHERE id = ? AND author = ?;
+		SET
+			content = ?,
+			status = CASE WHEN ? THEN 'hidden' ELSE status END,
+			spam_score = ?,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND (author = ? OR ?) AND status != 'removed';
 	`
 
-	res, err := m.db.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		sqlStatement,
-		input.Content,
+		filtered.Content,
+		quarantine,
+		spamScore,
 		input.ID,
 		input.Author,
+		input.AnyAuthor,
 	)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
@@ -318,39 +769,124 @@ func (m *Model) UpdateComment(ctx context.Context, input *UpdateCommentInput) er
 		return fmt.Errorf("no record was update, please verify request")
 	}
 
+	if err := m.saveCommentReferences(ctx, tx, int64(*input.ID), filtered.Content); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
+	sqlStatement = `
+		INSERT INTO comment_revision (comment_id, editor, content)
+		VALUES (?, ?, ?);
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, input.ID, input.Author, filtered.Content); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateComment", "error", err)
+		return err
+	}
+
 	m.log.InfoContext(ctx, "success UpdateComment")
 	return nil
 }
 
 type DeleteCommentInput struct {
-	ID       *int
-	Username *string
+	ID        *int
+	Username  *string
+	AnyAuthor bool
+	// RemoveAny marks comments of others removed instead of deleting them
+	RemoveAny bool
+	Reason    *string
 }
 
 func (m *Model) DeleteComment(ctx context.Context, input *DeleteCommentInput) error {
 	m.log.InfoContext(ctx, "start DeleteComment")
 
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteComment", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
 	sqlStatement := `
-		DELETE FROM comment WHERE id == ? AND author == ?;
+		DELETE FROM comment WHERE id == ? AND (author == ? OR ?);
 	`
 
-	res, err := m.db.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		sqlStatement,
 		*input.ID,
 		*input.Username,
+		input.AnyAuthor,
 	)
 	if err != nil {
 		m.log.ErrorContext(ctx, "fail DeleteComment", "error", err)
 		return err
 	}
 
+	auditAction := constant.AuditActionCommentDelete
 	if n, err := res.RowsAffected(); err != nil {
 		return err
+	} else if n == 0 && input.RemoveAny {
+		// NOTE: moderators take comments of others down the way the moderation queue does, replies stay
+		if err := removeComment(ctx, tx, input.ID, input.Username); err != nil {
+			m.log.ErrorContext(ctx, "fail DeleteComment", "error", err)
+			return err
+		}
+		auditAction = constant.AuditActionModerationRemove
 	} else if n == 0 {
 		return fmt.Errorf("no record was deleted, please check you request")
 	}
 
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Username,
+		Action:     auditAction,
+		TargetType: constant.AuditTargetComment,
+		TargetID:   strconv.Itoa(*input.ID),
+		Reason:     input.Reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteComment", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteComment", "error", err)
+		return err
+	}
+
 	m.log.InfoContext(ctx, "success DeleteComment")
 	return nil
 }
+
+// removeComment marks the comment removed and upholds its pending reports
+func removeComment(ctx context.Context, tx *sql.Tx, id *int, moderator *string) error {
+	sqlStatement := `
+		UPDATE comment
+		SET status = ?
+		WHERE id = ? AND status IN ('visible', 'hidden');
+	`
+
+	res, err := tx.ExecContext(ctx, sqlStatement, constant.CommentStatusRemoved, id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please check you request")
+	}
+
+	sqlStatement = `
+		UPDATE report
+		SET status = ?, resolved_by = ?, resolved_at = CURRENT_TIMESTAMP
+		WHERE comment_id = ? AND status = 'pending';
+	`
+
+	_, err = tx.ExecContext(ctx, sqlStatement, constant.ReportStatusUpheld, moderator, id)
+	return err
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Draft struct {
+	ThreadKey string
+	Content   string
+	UpdatedAt time.Time
+}
+
+func (m *Model) ReadDraft(ctx context.Context, username string, threadKey string) (*Draft, error) {
+	m.log.InfoContext(ctx, "start ReadDraft")
+
+	sqlStatement := `
+		SELECT d.thread_key, d.content, d.updated_at FROM main.draft d WHERE d.username = ? AND d.thread_key = ?;
+	`
+
+	d := new(Draft)
+	if err := m.db.QueryRowContext(ctx, sqlStatement, username, threadKey).Scan(&d.ThreadKey, &d.Content, &d.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("draft was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadDraft", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadDraft")
+	return d, nil
+}
+
+type UpsertDraftInput struct {
+	Username  *string
+	ThreadKey string
+	Content   string
+}
+
+// UpsertDraft saves the draft of the user for the thread key, replacing the previous one
+func (m *Model) UpsertDraft(ctx context.Context, input *UpsertDraftInput) error {
+	m.log.InfoContext(ctx, "start UpsertDraft")
+
+	sqlStatement := `
+		INSERT INTO draft (username, thread_key, content)
+		SELECT u.username, ?, ? FROM user_ u WHERE u.username = ?
+		ON CONFLICT(username, thread_key) DO UPDATE SET content = excluded.content, updated_at = CURRENT_TIMESTAMP;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.ThreadKey, input.Content, input.Username)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertDraft", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify user")
+	}
+
+	m.log.InfoContext(ctx, "success UpsertDraft")
+	return nil
+}
+
+func (m *Model) DeleteDraft(ctx context.Context, username *string, threadKey string) error {
+	m.log.InfoContext(ctx, "start DeleteDraft")
+
+	sqlStatement := `
+		DELETE FROM draft WHERE username = ? AND thread_key = ?;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, username, threadKey)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteDraft", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please verify thread key")
+	}
+
+	m.log.InfoContext(ctx, "success DeleteDraft")
+	return nil
+}
@@ -0,0 +1,295 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/filter"
+)
+
+type FilterRule struct {
+	ID        int
+	Kind      constant.FilterKind
+	Action    constant.FilterAction
+	Value     string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (m *Model) ReadFilterRules(ctx context.Context) ([]*FilterRule, error) {
+	m.log.InfoContext(ctx, "start ReadFilterRules")
+
+	sqlStatement := `
+		SELECT
+			f.id,
+			f.kind,
+			f.action,
+			f.value,
+			f.enabled,
+			f.created_at,
+			f.updated_at
+		FROM main.filter_rule f
+		ORDER BY f.id;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadFilterRules", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*FilterRule, 0)
+	for rows.Next() {
+		r := new(FilterRule)
+
+		if err = rows.Scan(
+			&r.ID,
+			&r.Kind,
+			&r.Action,
+			&r.Value,
+			&r.Enabled,
+			&r.CreatedAt,
+			&r.UpdatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadFilterRules", "error", err)
+			return nil, err
+		}
+
+		rules = append(rules, r)
+	}
+
+	m.log.InfoContext(ctx, "success ReadFilterRules")
+	return rules, nil
+}
+
+// applyFilterRules runs content through the enabled filter rules
+func (m *Model) applyFilterRules(ctx context.Context, content string) (*filter.Result, error) {
+	sqlStatement := `
+		SELECT
+			f.id,
+			f.kind,
+			f.action,
+			f.value
+		FROM main.filter_rule f
+		WHERE f.enabled
+		ORDER BY f.id;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*filter.Rule, 0)
+	for rows.Next() {
+		r := new(filter.Rule)
+
+		if err = rows.Scan(
+			&r.ID,
+			&r.Kind,
+			&r.Action,
+			&r.Value,
+		); err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, r)
+	}
+
+	return filter.Apply(content, rules)
+}
+
+type CreateFilterRuleInput struct {
+	Kind    constant.FilterKind
+	Action  constant.FilterAction
+	Value   string
+	Enabled bool
+	Actor   *string
+}
+
+func (m *Model) CreateFilterRule(ctx context.Context, input *CreateFilterRuleInput) error {
+	m.log.InfoContext(ctx, "start CreateFilterRule")
+
+	if err := filter.ValidateRule(input.Kind, input.Value); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		INSERT INTO filter_rule (kind, action, value, enabled)
+		VALUES (?, ?, ?, ?);
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Kind,
+		input.Action,
+		input.Value,
+		input.Enabled,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionFilterCreate,
+		TargetType: constant.AuditTargetFilterRule,
+		TargetID:   strconv.FormatInt(id, 10),
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateFilterRule", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success CreateFilterRule")
+	return nil
+}
+
+type UpdateFilterRuleInput struct {
+	ID      *int
+	Action  *constant.FilterAction
+	Value   *string
+	Enabled *bool
+	Actor   *string
+}
+
+func (m *Model) UpdateFilterRule(ctx context.Context, input *UpdateFilterRuleInput) error {
+	m.log.InfoContext(ctx, "start UpdateFilterRule")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var kind constant.FilterKind
+	if err := tx.QueryRowContext(ctx, `SELECT kind FROM filter_rule WHERE id = ?;`, input.ID).Scan(&kind); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("no record was found, please verify rule id")
+		}
+		m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+		return err
+	}
+
+	if input.Value != nil {
+		if err := filter.ValidateRule(kind, *input.Value); err != nil {
+			m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+			return err
+		}
+	}
+
+	sqlStatement := `
+		UPDATE filter_rule
+		SET
+			action = COALESCE(?, action),
+			value = COALESCE(?, value),
+			enabled = COALESCE(?, enabled),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?;
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Action,
+		input.Value,
+		input.Enabled,
+		input.ID,
+	); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+		return err
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionFilterUpdate,
+		TargetType: constant.AuditTargetFilterRule,
+		TargetID:   strconv.Itoa(*input.ID),
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateFilterRule", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success UpdateFilterRule")
+	return nil
+}
+
+type DeleteFilterRuleInput struct {
+	ID    *int
+	Actor *string
+}
+
+func (m *Model) DeleteFilterRule(ctx context.Context, input *DeleteFilterRuleInput) error {
+	m.log.InfoContext(ctx, "start DeleteFilterRule")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteFilterRule", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM filter_rule WHERE id = ?;`, input.ID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteFilterRule", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please check you request")
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionFilterDelete,
+		TargetType: constant.AuditTargetFilterRule,
+		TargetID:   strconv.Itoa(*input.ID),
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteFilterRule", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteFilterRule", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success DeleteFilterRule")
+	return nil
+}
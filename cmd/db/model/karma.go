@@ -0,0 +1,117 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+)
+
+// decayKarma returns points stored at updatedAt, halved for every KarmaHalfLife passed since
+func (m *Model) decayKarma(points float64, updatedAt *time.Time) float64 {
+	if m.conf.KarmaHalfLife <= 0 || updatedAt == nil {
+		return points
+	}
+
+	return points * math.Pow(0.5, float64(time.Since(*updatedAt))/float64(m.conf.KarmaHalfLife))
+}
+
+// karmaOf returns the current karma of a user from its stored value, users who never got a vote have none
+func (m *Model) karmaOf(points *float64, updatedAt *time.Time) int {
+	if points == nil {
+		return 0
+	}
+
+	return int(math.Round(m.decayKarma(*points, updatedAt)))
+}
+
+// readKarma returns the current karma of the user
+func (m *Model) readKarma(ctx context.Context, username *string) (int, error) {
+	sqlStatement := `
+		SELECT k.points, k.updated_at FROM karma k WHERE k.username = ?;
+	`
+
+	var points *float64
+	var updatedAt *time.Time
+	if err := m.db.QueryRowContext(ctx, sqlStatement, username).Scan(&points, &updatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return m.karmaOf(points, updatedAt), nil
+}
+
+// capKarmaVote limits the share of karma a single voter gives or takes from a user
+func (m *Model) capKarmaVote(net int) int {
+	if m.conf.KarmaVoterCap <= 0 {
+		return net
+	}
+
+	return max(-m.conf.KarmaVoterCap, min(m.conf.KarmaVoterCap, net))
+}
+
+// applyKarmaVote moves the karma of the author of the comment after the voter changed their rate from oldRate to newRate
+func (m *Model) applyKarmaVote(ctx context.Context, tx *sql.Tx, voter *string, commentID *int, oldRate int, newRate int) error {
+	if oldRate == newRate {
+		return nil
+	}
+
+	sqlStatement := `
+		SELECT c.author, COALESCE(kv.net, 0)
+		FROM comment c
+		LEFT JOIN karma_vote kv ON kv.voter = ? AND kv.recipient = c.author
+		WHERE c.id = ?;
+	`
+
+	var recipient string
+	var net int
+	if err := tx.QueryRowContext(ctx, sqlStatement, voter, commentID).Scan(&recipient, &net); err != nil {
+		return err
+	}
+
+	newNet := net + newRate - oldRate
+
+	sqlStatement = `
+		INSERT INTO karma_vote (voter, recipient, net) VALUES (?, ?, ?)
+		ON CONFLICT(voter, recipient) DO UPDATE SET net = excluded.net;
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, voter, recipient, newNet); err != nil {
+		return err
+	}
+
+	delta := m.capKarmaVote(newNet) - m.capKarmaVote(net)
+	if delta == 0 {
+		return nil
+	}
+
+	sqlStatement = `
+		SELECT k.points, k.updated_at FROM karma k WHERE k.username = ?;
+	`
+
+	var points *float64
+	var updatedAt *time.Time
+	if err := tx.QueryRowContext(ctx, sqlStatement, recipient).Scan(&points, &updatedAt); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	// NOTE: the decay so far is settled before the vote is added, karma decays from now on
+	karma := float64(delta)
+	if points != nil {
+		karma += m.decayKarma(*points, updatedAt)
+	}
+
+	sqlStatement = `
+		INSERT INTO karma (username, points, updated_at) VALUES (?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(username) DO UPDATE SET points = excluded.points, updated_at = excluded.updated_at;
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, recipient, karma); err != nil {
+		return err
+	}
+
+	return nil
+}
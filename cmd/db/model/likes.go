@@ -2,6 +2,8 @@ package model
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 )
 
@@ -14,35 +16,87 @@ type UpsertLikeInput struct {
 func (m *Model) UpsertLike(ctx context.Context, input *UpsertLikeInput) error {
 	m.log.InfoContext(ctx, "start UpsertLike")
 
+	var commentAuthor string
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT c.author FROM comment c WHERE c.id = ?;`,
+		input.CommentID,
+	).Scan(&commentAuthor); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("comment was not found")
+		}
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+		return err
+	}
+	if commentAuthor == *input.Author {
+		return fmt.Errorf("you cannot vote on your own comment")
+	}
+
+	blocked, err := m.isBlockedByCommentAuthor(ctx, input.Author, input.CommentID, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+		return err
+	}
+	if blocked {
+		return fmt.Errorf("you cannot vote on comments of this user")
+	}
+
+	if *input.Rate < 0 {
+		karma, err := m.readKarma(ctx, input.Author)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+			return err
+		}
+		if karma < m.conf.DownvoteKarma {
+			return fmt.Errorf("you need at least %d karma to downvote", m.conf.DownvoteKarma)
+		}
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var oldRate int
+	if err := tx.QueryRowContext(
+		ctx,
+		`SELECT l.rate FROM like_ l WHERE l.author = ? AND l.comment_id = ?;`,
+		input.Author,
+		input.CommentID,
+	).Scan(&oldRate); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+		return err
+	}
+
 	sqlStatement := `
 		INSERT INTO like_ (author, comment_id, rate)
-		SELECT ?, ?, ?
-		WHERE NOT EXISTS (
-			SELECT * FROM comment c WHERE c.id = ? AND c.author = ?
-		)
+		VALUES (?, ?, ?)
 		ON CONFLICT(author, comment_id)
 			DO UPDATE SET rate = ?;
 	`
 
-	res, err := m.db.ExecContext(
+	if _, err := tx.ExecContext(
 		ctx,
 		sqlStatement,
 		input.Author,
 		input.CommentID,
 		input.Rate,
-		input.CommentID,
-		input.Author,
 		input.Rate,
-	)
-	if err != nil {
+	); err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
+		return err
+	}
+
+	if err := m.applyKarmaVote(ctx, tx, input.Author, input.CommentID, oldRate, *input.Rate); err != nil {
 		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
 		return err
 	}
 
-	if n, err := res.RowsAffected(); err != nil {
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail UpsertLike", "error", err)
 		return err
-	} else if n == 0 {
-		return fmt.Errorf("no record was inserted, please check your request")
 	}
 
 	m.log.InfoContext(ctx, "success UpsertLike")
@@ -1,17 +1,20 @@
 package model
 
 import (
+	"context"
 	"database/sql"
 	"log/slog"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/database"
 	"github.com/talgat-ruby/interactive-comments-api/configs"
+	"github.com/talgat-ruby/interactive-comments-api/internal/markdown"
 )
 
 type Model struct {
 	log  *slog.Logger
 	conf *configs.DBConfig
 	db   *sql.DB
+	md   *markdown.Renderer
 }
 
 func New(log *slog.Logger, conf *configs.DBConfig) (*Model, error) {
@@ -24,6 +27,11 @@ func New(log *slog.Logger, conf *configs.DBConfig) (*Model, error) {
 		log:  log,
 		conf: conf,
 		db:   db,
+		md:   markdown.New(conf.MarkdownFeatures),
+	}
+
+	if err := m.migrateAvatars(context.Background()); err != nil {
+		return nil, err
 	}
 
 	return m, nil
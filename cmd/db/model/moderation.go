@@ -0,0 +1,279 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/pkg/utils"
+)
+
+type ModerationReport struct {
+	ID        int
+	Author    string
+	Reason    string
+	Details   *string
+	CreatedAt time.Time
+}
+
+type ModerationQueueItem struct {
+	CommentID int
+	Content   string
+	Author    string
+	ParentID  *int
+	Status    string
+	SpamScore *float64
+	CreatedAt time.Time
+	Reports   []*ModerationReport
+}
+
+func (m *Model) ReadModerationQueue(ctx context.Context) ([]*ModerationQueueItem, error) {
+	m.log.InfoContext(ctx, "start ReadModerationQueue")
+
+	sqlStatement := `
+		SELECT
+			c.id,
+			c.content,
+			c.author,
+			c.parent_id,
+			c.status,
+			c.spam_score,
+			c.created_at
+		FROM main.comment c
+		LEFT JOIN
+			(
+				SELECT
+					comment_id,
+					COUNT(*) as count
+				FROM
+					report
+				WHERE
+					status = 'pending'
+				GROUP BY
+					comment_id
+			) as r
+			ON
+				c.id = r.comment_id
+		WHERE c.status = 'hidden' OR (c.status != 'scheduled' AND r.count > 0)
+		ORDER BY r.count DESC, c.created_at ASC;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadModerationQueue", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*ModerationQueueItem, 0)
+	mItems := make(map[int]*ModerationQueueItem)
+	for rows.Next() {
+		item := &ModerationQueueItem{
+			Reports: make([]*ModerationReport, 0),
+		}
+
+		if err = rows.Scan(
+			&item.CommentID,
+			&item.Content,
+			&item.Author,
+			&item.ParentID,
+			&item.Status,
+			&item.SpamScore,
+			&item.CreatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadModerationQueue", "error", err)
+			return nil, err
+		}
+
+		items = append(items, item)
+		mItems[item.CommentID] = item
+	}
+
+	sqlStatement = `
+		SELECT
+			r.id,
+			r.comment_id,
+			r.author,
+			r.reason,
+			r.details,
+			r.created_at
+		FROM main.report r
+		WHERE r.status = 'pending'
+		ORDER BY r.created_at ASC;
+	`
+
+	rRows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadModerationQueue", "error", err)
+		return nil, err
+	}
+	defer rRows.Close()
+
+	for rRows.Next() {
+		var commentID int
+		r := new(ModerationReport)
+
+		if err = rRows.Scan(
+			&r.ID,
+			&commentID,
+			&r.Author,
+			&r.Reason,
+			&r.Details,
+			&r.CreatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadModerationQueue", "error", err)
+			return nil, err
+		}
+
+		if item, ok := mItems[commentID]; ok {
+			item.Reports = append(item.Reports, r)
+		}
+	}
+
+	m.log.InfoContext(ctx, "success ReadModerationQueue")
+	return items, nil
+}
+
+type ResolveModerationInput struct {
+	CommentID *int
+	Moderator *string
+	Action    constant.ModerationAction
+	Reason    *string
+}
+
+func (m *Model) ResolveModeration(ctx context.Context, input *ResolveModerationInput) error {
+	m.log.InfoContext(ctx, "start ResolveModeration")
+
+	outcome, err := moderationActionOutcome(input.Action)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		SELECT
+			c.status,
+			c.status != 'scheduled' AND (
+				c.status = 'hidden' OR EXISTS (
+					SELECT * FROM report r WHERE r.comment_id = c.id AND r.status = 'pending'
+				)
+			)
+		FROM comment c
+		WHERE c.id = ?;
+	`
+
+	var (
+		status constant.CommentStatus
+		queued bool
+	)
+	if err := tx.QueryRowContext(ctx, sqlStatement, input.CommentID).Scan(&status, &queued); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("no record was found, please verify comment id")
+		}
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	} else if !queued {
+		return fmt.Errorf("comment is not in the moderation queue")
+	}
+
+	// NOTE: dismissing only closes the reports, a held comment stays hidden
+	if outcome.commentStatus != nil && *outcome.commentStatus != status {
+		sqlStatement = `
+			UPDATE comment
+			SET status = ?
+			WHERE id = ? AND status != 'scheduled';
+		`
+
+		if _, err := tx.ExecContext(ctx, sqlStatement, *outcome.commentStatus, input.CommentID); err != nil {
+			m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+			return err
+		}
+
+		if *outcome.commentStatus == constant.CommentStatusVisible {
+			if err := notifySubscribers(ctx, tx, int64(*input.CommentID)); err != nil {
+				m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+				return err
+			}
+		}
+	}
+
+	sqlStatement = `
+		UPDATE report
+		SET status = ?, resolved_by = ?, resolved_at = CURRENT_TIMESTAMP
+		WHERE comment_id = ? AND status = 'pending';
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		outcome.reportStatus,
+		input.Moderator,
+		input.CommentID,
+	); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Moderator,
+		Action:     outcome.auditAction,
+		TargetType: constant.AuditTargetComment,
+		TargetID:   strconv.Itoa(*input.CommentID),
+		Reason:     input.Reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveModeration", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success ResolveModeration")
+	return nil
+}
+
+type moderationOutcome struct {
+	commentStatus *constant.CommentStatus
+	reportStatus  constant.ReportStatus
+	auditAction   constant.AuditAction
+}
+
+// moderationActionOutcome returns what a moderation action does to the comment and its reports.
+// Approving keeps the comment and rejects its reports, dismissing closes the reports without a verdict
+// and leaves the comment as it is.
+func moderationActionOutcome(action constant.ModerationAction) (*moderationOutcome, error) {
+	switch action {
+	case constant.ModerationActionApprove:
+		return &moderationOutcome{
+			commentStatus: utils.ToPtr(constant.CommentStatusVisible),
+			reportStatus:  constant.ReportStatusRejected,
+			auditAction:   constant.AuditActionModerationApprove,
+		}, nil
+	case constant.ModerationActionRemove:
+		return &moderationOutcome{
+			commentStatus: utils.ToPtr(constant.CommentStatusRemoved),
+			reportStatus:  constant.ReportStatusUpheld,
+			auditAction:   constant.AuditActionModerationRemove,
+		}, nil
+	case constant.ModerationActionDismiss:
+		return &moderationOutcome{
+			reportStatus: constant.ReportStatusDismissed,
+			auditAction:  constant.AuditActionModerationDismiss,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown moderation action %q", action)
+	}
+}
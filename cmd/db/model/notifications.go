@@ -0,0 +1,127 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type Notification struct {
+	ID        int
+	Kind      constant.NotificationKind
+	CommentID int
+	ThreadID  int
+	Actor     string
+	Excerpt   string
+	Read      bool
+	CreatedAt time.Time
+}
+
+type ReadNotificationsInput struct {
+	Username   string
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+// notificationFilter keeps notifications of the n.recipient user about comments which are still visible
+const notificationFilter = `
+	n.recipient = ? AND (n.read_at IS NULL OR NOT ?) AND c.status = 'visible'
+`
+
+// ReadNotifications lists the inbox of the user, newest first
+func (m *Model) ReadNotifications(ctx context.Context, input *ReadNotificationsInput) ([]*Notification, int, error) {
+	m.log.InfoContext(ctx, "start ReadNotifications")
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.notification n JOIN main.comment c ON n.comment_id = c.id WHERE `+notificationFilter,
+		input.Username,
+		input.UnreadOnly,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadNotifications", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT n.id, n.kind, c.id, COALESCE(c.parent_id, c.id), c.author, c.content, n.read_at IS NOT NULL, n.created_at
+		FROM main.notification n
+		JOIN main.comment c ON n.comment_id = c.id
+		WHERE ` + notificationFilter + `
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, input.Username, input.UnreadOnly, input.Limit, input.Offset)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadNotifications", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	notifications := make([]*Notification, 0)
+	for rows.Next() {
+		n := new(Notification)
+		var content string
+
+		if err := rows.Scan(
+			&n.ID,
+			&n.Kind,
+			&n.CommentID,
+			&n.ThreadID,
+			&n.Actor,
+			&content,
+			&n.Read,
+			&n.CreatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadNotifications", "error", err)
+			return nil, 0, err
+		}
+
+		n.Excerpt = excerpt(content)
+		notifications = append(notifications, n)
+	}
+
+	m.log.InfoContext(ctx, "success ReadNotifications")
+	return notifications, total, nil
+}
+
+// MarkNotificationsRead marks the whole inbox of the user as read
+func (m *Model) MarkNotificationsRead(ctx context.Context, username *string) error {
+	m.log.InfoContext(ctx, "start MarkNotificationsRead")
+
+	sqlStatement := `
+		UPDATE notification SET read_at = CURRENT_TIMESTAMP WHERE recipient = ? AND read_at IS NULL;
+	`
+
+	if _, err := m.db.ExecContext(ctx, sqlStatement, username); err != nil {
+		m.log.ErrorContext(ctx, "fail MarkNotificationsRead", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success MarkNotificationsRead")
+	return nil
+}
+
+// notifySubscribers fans a visible reply out to the inboxes of everybody following its thread except
+// its author and users who block or mute the author. Replies of shadow-banned users reach nobody.
+func notifySubscribers(ctx context.Context, tx *sql.Tx, commentID int64) error {
+	sqlStatement := `
+		INSERT INTO notification (recipient, kind, comment_id)
+		SELECT s.username, ?, c.id
+		FROM comment c
+		JOIN subscription s ON s.comment_id = c.parent_id
+		WHERE c.id = ? AND c.status = 'visible' AND s.username != c.author AND NOT EXISTS (
+			SELECT * FROM user_block b WHERE b.blocker = s.username AND b.blocked = c.author
+		) AND NOT EXISTS (
+			SELECT * FROM active_ban ab WHERE ab.username = c.author AND ab.status = 'shadow_banned'
+		)
+		ON CONFLICT(recipient, kind, comment_id) DO NOTHING;
+	`
+
+	_, err := tx.ExecContext(ctx, sqlStatement, constant.NotificationKindReply, commentID)
+	return err
+}
@@ -0,0 +1,242 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Poll struct {
+	Question string
+	Multiple bool
+	ClosesAt *time.Time
+	Closed   bool
+	// Voters is the number of users who voted, in multiple choice polls it differs from the sum of option votes
+	Voters  int
+	Options []*PollOption
+}
+
+type PollOption struct {
+	ID    int
+	Text  string
+	Votes int
+	// Voted tells whether the viewer voted for the option
+	Voted bool
+}
+
+type CreatePollInput struct {
+	Question string
+	Options  []string
+	Multiple bool
+	ClosesAt *time.Time
+}
+
+// createPoll adds the poll to the top-level comment
+func createPoll(ctx context.Context, tx *sql.Tx, commentID int64, input *CreatePollInput) error {
+	sqlStatement := `
+		INSERT INTO poll (comment_id, question, multiple, closes_at) VALUES (?, ?, ?, ?);
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, commentID, input.Question, input.Multiple, utcTime(input.ClosesAt)); err != nil {
+		return err
+	}
+
+	sqlStatement = `
+		INSERT INTO poll_option (comment_id, text) VALUES (?, ?);
+	`
+
+	for _, o := range input.Options {
+		if _, err := tx.ExecContext(ctx, sqlStatement, commentID, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type VotePollInput struct {
+	Author    *string
+	CommentID *int
+	OptionIDs []int
+}
+
+// VotePoll casts the ballot of the user in the poll of the comment, replacing their previous one
+func (m *Model) VotePoll(ctx context.Context, input *VotePollInput) error {
+	m.log.InfoContext(ctx, "start VotePoll")
+
+	blocked, err := m.isBlockedByCommentAuthor(ctx, input.Author, input.CommentID, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+	if blocked {
+		return fmt.Errorf("you cannot vote on comments of this user")
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		SELECT p.multiple, p.closes_at IS NOT NULL AND p.closes_at <= CURRENT_TIMESTAMP
+		FROM poll p
+		JOIN comment c ON p.comment_id = c.id
+		WHERE p.comment_id = ? AND c.status = 'visible';
+	`
+
+	var multiple, closed bool
+	if err := tx.QueryRowContext(ctx, sqlStatement, input.CommentID).Scan(&multiple, &closed); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("poll was not found")
+		}
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+
+	if closed {
+		return fmt.Errorf("poll is closed")
+	}
+	if !multiple && len(input.OptionIDs) > 1 {
+		return fmt.Errorf("poll allows a single choice")
+	}
+
+	args := make([]any, 0, len(input.OptionIDs)+1)
+	args = append(args, input.CommentID)
+	for _, id := range input.OptionIDs {
+		args = append(args, id)
+	}
+
+	sqlStatement = fmt.Sprintf(`
+		SELECT COUNT(*) FROM poll_option o WHERE o.comment_id = ? AND o.id IN (%s);
+	`, strings.TrimSuffix(strings.Repeat("?,", len(input.OptionIDs)), ","))
+
+	var found int
+	if err := tx.QueryRowContext(ctx, sqlStatement, args...).Scan(&found); err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+	if found != len(input.OptionIDs) {
+		return fmt.Errorf("options were not found in this poll")
+	}
+
+	sqlStatement = `
+		INSERT INTO poll_vote (author, comment_id) VALUES (?, ?)
+		ON CONFLICT(author, comment_id) DO UPDATE SET updated_at = CURRENT_TIMESTAMP
+		RETURNING id;
+	`
+
+	var voteID int64
+	if err := tx.QueryRowContext(ctx, sqlStatement, input.Author, input.CommentID).Scan(&voteID); err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM poll_vote_option WHERE vote_id = ?;`, voteID); err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+
+	for _, id := range input.OptionIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO poll_vote_option (vote_id, option_id) VALUES (?, ?);`, voteID, id); err != nil {
+			m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail VotePoll", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success VotePoll")
+	return nil
+}
+
+// readCommentPolls returns polls of the comments with their results as the viewer sees them, by comment id.
+// Like likes, ballots of shadow-banned users only count for themselves.
+func (m *Model) readCommentPolls(ctx context.Context, commentIDs []int, viewer string) (map[int]*Poll, error) {
+	mPolls := make(map[int]*Poll)
+
+	for _, batch := range batches(commentIDs, batchSize) {
+		if err := m.readCommentPollsBatch(ctx, batch, viewer, mPolls); err != nil {
+			return nil, err
+		}
+	}
+
+	return mPolls, nil
+}
+
+// readCommentPollsBatch adds polls of commentIDs to mPolls
+func (m *Model) readCommentPollsBatch(ctx context.Context, commentIDs []int, viewer string, mPolls map[int]*Poll) error {
+	args := make([]any, 0, len(commentIDs)+2)
+	args = append(args, viewer, viewer)
+	for _, id := range commentIDs {
+		args = append(args, id)
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		WITH vote AS (
+			SELECT v.* FROM main.poll_vote v
+			WHERE v.author = ? OR NOT EXISTS (
+				SELECT * FROM main.active_ban ab WHERE ab.username = v.author AND ab.status = 'shadow_banned'
+			)
+		)
+		SELECT
+			p.comment_id,
+			p.question,
+			p.multiple,
+			p.closes_at,
+			p.closes_at IS NOT NULL AND p.closes_at <= CURRENT_TIMESTAMP,
+			(SELECT COUNT(*) FROM vote v WHERE v.comment_id = p.comment_id),
+			o.id,
+			o.text,
+			(SELECT COUNT(*) FROM main.poll_vote_option vo JOIN vote v ON vo.vote_id = v.id WHERE vo.option_id = o.id),
+			EXISTS (
+				SELECT * FROM main.poll_vote_option vo JOIN vote v ON vo.vote_id = v.id WHERE vo.option_id = o.id AND v.author = ?
+			)
+		FROM main.poll p
+		JOIN main.poll_option o ON o.comment_id = p.comment_id
+		WHERE p.comment_id IN (%s)
+		ORDER BY o.id ASC;
+	`, strings.TrimSuffix(strings.Repeat("?,", len(commentIDs)), ","))
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID int
+		p := new(Poll)
+		o := new(PollOption)
+
+		if err := rows.Scan(
+			&commentID,
+			&p.Question,
+			&p.Multiple,
+			&p.ClosesAt,
+			&p.Closed,
+			&p.Voters,
+			&o.ID,
+			&o.Text,
+			&o.Votes,
+			&o.Voted,
+		); err != nil {
+			return err
+		}
+
+		if _, ok := mPolls[commentID]; !ok {
+			mPolls[commentID] = p
+		}
+		mPolls[commentID].Options = append(mPolls[commentID].Options, o)
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,241 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/avatar"
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type UserProfile struct {
+	Username     string
+	DisplayName  *string
+	Bio          *string
+	AvatarUrl    string
+	Role         constant.Role
+	CreatedAt    time.Time
+	CommentCount int
+	Karma        int
+	// CollapseScore is only read for the user themselves
+	CollapseScore *int
+}
+
+// userCommentFilter keeps comments of the u.username user which the viewer can see in the thread
+const userCommentFilter = `
+	c.author = u.username AND c.status = 'visible' AND
+	(c.parent_id IS NULL OR EXISTS (SELECT * FROM main.comment pc WHERE pc.id = c.parent_id AND pc.status = 'visible')) AND
+	(u.username = ? OR NOT EXISTS (
+		SELECT * FROM main.active_ban ab WHERE ab.username = u.username AND ab.status = 'shadow_banned'
+	))
+`
+
+func (m *Model) ReadUserProfile(ctx context.Context, username string, viewer string) (*UserProfile, error) {
+	m.log.InfoContext(ctx, "start ReadUserProfile")
+
+	sqlStatement := `
+		SELECT
+			u.username,
+			u.display_name,
+			u.bio,
+			a.etag,
+			u.role,
+			u.created_at,
+			(SELECT COUNT(*) FROM main.comment c WHERE ` + userCommentFilter + `) AS comment_count,
+			k.points,
+			k.updated_at,
+			CASE WHEN u.username = ? THEN u.collapse_score END AS collapse_score
+		FROM main.user_ u
+		LEFT JOIN main.avatar a ON u.username = a.username
+		LEFT JOIN main.karma k ON u.username = k.username
+		WHERE u.username = ?;
+	`
+
+	p := new(UserProfile)
+	var avatarEtag *string
+	var karmaPoints *float64
+	var karmaUpdatedAt *time.Time
+	if err := m.db.QueryRowContext(ctx, sqlStatement, viewer, viewer, username).Scan(
+		&p.Username,
+		&p.DisplayName,
+		&p.Bio,
+		&avatarEtag,
+		&p.Role,
+		&p.CreatedAt,
+		&p.CommentCount,
+		&karmaPoints,
+		&karmaUpdatedAt,
+		&p.CollapseScore,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("user was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadUserProfile", "error", err)
+		return nil, err
+	}
+
+	p.AvatarUrl = avatar.URL(p.Username, avatarEtag)
+	p.Karma = m.karmaOf(karmaPoints, karmaUpdatedAt)
+
+	m.log.InfoContext(ctx, "success ReadUserProfile")
+	return p, nil
+}
+
+type UpdateUserProfileInput struct {
+	Username *string
+	// DisplayName and Bio are left as they are when nil and cleared when empty
+	DisplayName *string
+	Bio         *string
+	// CollapseScore is left as it is when nil
+	CollapseScore *int
+}
+
+func (m *Model) UpdateUserProfile(ctx context.Context, input *UpdateUserProfileInput) error {
+	m.log.InfoContext(ctx, "start UpdateUserProfile")
+
+	sqlStatement := `
+		UPDATE user_
+		SET
+			display_name = CASE WHEN ? IS NULL THEN display_name ELSE NULLIF(?, '') END,
+			bio = CASE WHEN ? IS NULL THEN bio ELSE NULLIF(?, '') END,
+			collapse_score = COALESCE(?, collapse_score),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE username = ?;
+	`
+
+	res, err := m.db.ExecContext(
+		ctx,
+		sqlStatement,
+		input.DisplayName,
+		input.DisplayName,
+		input.Bio,
+		input.Bio,
+		input.CollapseScore,
+		input.Username,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateUserProfile", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify user")
+	}
+
+	m.log.InfoContext(ctx, "success UpdateUserProfile")
+	return nil
+}
+
+type UserComment struct {
+	ID          int
+	Content     string
+	ContentHtml string
+	ParentID    *int
+	Addressee   *string
+	Likes       int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ReadUserCommentsInput struct {
+	Username string
+	Viewer   string
+	Limit    int
+	Offset   int
+}
+
+func (m *Model) ReadUserComments(ctx context.Context, input *ReadUserCommentsInput) ([]*UserComment, int, error) {
+	m.log.InfoContext(ctx, "start ReadUserComments")
+
+	var exists bool
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT * FROM main.user_ u WHERE u.username = ?);`,
+		input.Username,
+	).Scan(&exists); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserComments", "error", err)
+		return nil, 0, err
+	} else if !exists {
+		err := fmt.Errorf("user was not found")
+		m.log.ErrorContext(ctx, "fail ReadUserComments", "error", err)
+		return nil, 0, err
+	}
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.user_ u JOIN main.comment c ON c.author = u.username WHERE u.username = ? AND `+userCommentFilter,
+		input.Username,
+		input.Viewer,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserComments", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT
+			c.id,
+			c.content,
+			c.parent_id,
+			c.addressee,
+			(
+				SELECT COALESCE(SUM(l.rate), 0)
+				FROM main.like_ l
+				WHERE l.comment_id = c.id AND (l.author = ? OR NOT EXISTS (
+					SELECT * FROM main.active_ban ab WHERE ab.username = l.author AND ab.status = 'shadow_banned'
+				))
+			) AS likes,
+			c.created_at,
+			c.updated_at
+		FROM main.user_ u
+		JOIN main.comment c ON c.author = u.username
+		WHERE u.username = ? AND ` + userCommentFilter + `
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(
+		ctx,
+		sqlStatement,
+		input.Viewer,
+		input.Username,
+		input.Viewer,
+		input.Limit,
+		input.Offset,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUserComments", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	comments := make([]*UserComment, 0)
+	for rows.Next() {
+		c := new(UserComment)
+
+		if err = rows.Scan(
+			&c.ID,
+			&c.Content,
+			&c.ParentID,
+			&c.Addressee,
+			&c.Likes,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadUserComments", "error", err)
+			return nil, 0, err
+		}
+
+		// NOTE: without their threads references would point nowhere, so they stay text
+		c.ContentHtml = m.md.Render(c.Content, nil)
+		comments = append(comments, c)
+	}
+
+	m.log.InfoContext(ctx, "success ReadUserComments")
+	return comments, total, nil
+}
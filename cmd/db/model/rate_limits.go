@@ -0,0 +1,92 @@
+package model
+
+import (
+	"context"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/ratelimit"
+)
+
+func (m *Model) ReadRateLimitBuckets(ctx context.Context) (map[string]ratelimit.Bucket, error) {
+	m.log.InfoContext(ctx, "start ReadRateLimitBuckets")
+
+	sqlStatement := `
+		SELECT
+			b.key,
+			b.tokens,
+			b.updated_at,
+			b.full_at
+		FROM main.rate_limit_bucket b;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadRateLimitBuckets", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make(map[string]ratelimit.Bucket)
+	for rows.Next() {
+		var (
+			key string
+			b   ratelimit.Bucket
+		)
+
+		if err = rows.Scan(
+			&key,
+			&b.Tokens,
+			&b.UpdatedAt,
+			&b.FullAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadRateLimitBuckets", "error", err)
+			return nil, err
+		}
+
+		buckets[key] = b
+	}
+
+	m.log.InfoContext(ctx, "success ReadRateLimitBuckets")
+	return buckets, nil
+}
+
+// SaveRateLimitBuckets replaces persisted buckets with the given ones
+func (m *Model) SaveRateLimitBuckets(ctx context.Context, buckets map[string]ratelimit.Bucket) error {
+	m.log.InfoContext(ctx, "start SaveRateLimitBuckets")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail SaveRateLimitBuckets", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM rate_limit_bucket;`); err != nil {
+		m.log.ErrorContext(ctx, "fail SaveRateLimitBuckets", "error", err)
+		return err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO rate_limit_bucket (key, tokens, updated_at, full_at)
+		VALUES (?, ?, ?, ?);
+	`)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail SaveRateLimitBuckets", "error", err)
+		return err
+	}
+	defer stmt.Close()
+
+	for key, b := range buckets {
+		if _, err := stmt.ExecContext(ctx, key, b.Tokens, b.UpdatedAt, b.FullAt); err != nil {
+			m.log.ErrorContext(ctx, "fail SaveRateLimitBuckets", "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail SaveRateLimitBuckets", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success SaveRateLimitBuckets")
+	return nil
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// excerptLength is how many characters of a quoted comment are returned with the quote
+const excerptLength = 140
+
+type Quote struct {
+	ID      int
+	Author  string
+	Excerpt string
+}
+
+type commentReferences struct {
+	// refs are ids of comments a comment refers to
+	refs map[int][]int
+	// quotedBy are ids of comments referring to a comment
+	quotedBy map[int][]int
+}
+
+// isQuotable checks the comment is visible to username and belongs to the thread of parentID
+func (m *Model) isQuotable(ctx context.Context, id *int, parentID *int, username *string) (bool, error) {
+	if parentID == nil {
+		return false, nil
+	}
+
+	sqlStatement := `
+		SELECT EXISTS (
+			SELECT * FROM comment c
+			WHERE c.id = ? AND COALESCE(c.parent_id, c.id) = ? AND c.status = 'visible' AND NOT EXISTS (
+				SELECT * FROM user_block b WHERE b.blocker = ? AND b.blocked = c.author AND b.kind = 'mute'
+			) AND (c.author = ? OR NOT EXISTS (
+				SELECT * FROM active_ban ab WHERE ab.username = c.author AND ab.status = 'shadow_banned'
+			))
+		);
+	`
+
+	var quotable bool
+	if err := m.db.QueryRowContext(ctx, sqlStatement, id, parentID, username, username).Scan(&quotable); err != nil {
+		return false, err
+	}
+
+	return quotable, nil
+}
+
+// saveCommentReferences replaces references of the comment with the explicit quote and the ">>123" references
+// in its content. References to comments outside the thread of the comment are dropped.
+func (m *Model) saveCommentReferences(ctx context.Context, tx *sql.Tx, commentID int64, content string) error {
+	sqlStatement := `
+		DELETE FROM comment_reference WHERE comment_id = ?;
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, commentID); err != nil {
+		return err
+	}
+
+	args := []any{commentID}
+	for _, id := range m.md.References(content) {
+		args = append(args, id)
+	}
+
+	sqlStatement = fmt.Sprintf(`
+		INSERT INTO comment_reference (comment_id, referenced_id)
+		SELECT c.id, r.id
+		FROM comment c
+		JOIN comment r ON COALESCE(r.parent_id, r.id) = COALESCE(c.parent_id, c.id) AND r.id < c.id
+		WHERE c.id = ? AND (r.id = c.quoted_comment_id OR r.id IN (%s));
+	`, strings.Repeat("?,", len(args)-1)+"NULL")
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readCommentReferences returns references between the comments
+func (m *Model) readCommentReferences(ctx context.Context, commentIDs []int) (*commentReferences, error) {
+	refs := &commentReferences{
+		refs:     make(map[int][]int),
+		quotedBy: make(map[int][]int),
+	}
+	if len(commentIDs) == 0 {
+		return refs, nil
+	}
+
+	// NOTE: references to comments which are not read, e.g. hidden ones, are dropped
+	read := make(map[int]struct{}, len(commentIDs))
+	for _, id := range commentIDs {
+		read[id] = struct{}{}
+	}
+
+	for _, batch := range batches(commentIDs, batchSize) {
+		args := make([]any, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+
+		sqlStatement := fmt.Sprintf(`
+			SELECT
+				r.comment_id,
+				r.referenced_id
+			FROM main.comment_reference r
+			WHERE r.comment_id IN (%s)
+			ORDER BY r.comment_id ASC, r.referenced_id ASC;
+		`, strings.TrimSuffix(strings.Repeat("?,", len(batch)), ","))
+
+		if err := m.readReferenceRows(ctx, sqlStatement, args, read, refs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ids := range refs.quotedBy {
+		sort.Ints(ids)
+	}
+
+	return refs, nil
+}
+
+func (m *Model) readReferenceRows(ctx context.Context, sqlStatement string, args []any, read map[int]struct{}, refs *commentReferences) error {
+	rows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID, referencedID int
+		if err := rows.Scan(&commentID, &referencedID); err != nil {
+			return err
+		}
+
+		if _, ok := read[referencedID]; !ok {
+			continue
+		}
+
+		refs.refs[commentID] = append(refs.refs[commentID], referencedID)
+		refs.quotedBy[referencedID] = append(refs.quotedBy[referencedID], commentID)
+	}
+
+	return rows.Err()
+}
+
+// excerpt shortens content of a quoted comment to a single line
+func excerpt(content string) string {
+	s := strings.Join(strings.Fields(content), " ")
+	if r := []rune(s); len(r) > excerptLength {
+		return string(r[:excerptLength]) + "…"
+	}
+
+	return s
+}
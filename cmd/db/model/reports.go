@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+type CreateReportInput struct {
+	Author    *string
+	CommentID *int
+	Reason    string
+	Details   *string
+}
+
+func (m *Model) CreateReport(ctx context.Context, input *CreateReportInput) error {
+	m.log.InfoContext(ctx, "start CreateReport")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateReport", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		INSERT INTO report (author, comment_id, reason, details)
+		SELECT ?, ?, ?, ?
+		WHERE EXISTS (
+			SELECT * FROM comment c WHERE c.id = ? AND c.author != ? AND c.status = 'visible'
+		)
+		ON CONFLICT(author, comment_id) DO NOTHING;
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Author,
+		input.CommentID,
+		input.Reason,
+		input.Details,
+		input.CommentID,
+		input.Author,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateReport", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify comment id or that you have not reported it already")
+	}
+
+	// NOTE: hide the comment until a moderator looks at it once enough people reported it
+	if m.conf.ReportThreshold > 0 {
+		sqlStatement = `
+			UPDATE comment
+			SET status = 'hidden'
+			WHERE id = ? AND status = 'visible' AND (
+				SELECT COUNT(*) FROM report r WHERE r.comment_id = ? AND r.status = 'pending'
+			) >= ?;
+		`
+
+		if _, err := tx.ExecContext(
+			ctx,
+			sqlStatement,
+			input.CommentID,
+			input.CommentID,
+			m.conf.ReportThreshold,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail CreateReport", "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail CreateReport", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success CreateReport")
+	return nil
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+type ScheduledComment struct {
+	ID        int
+	Content   string
+	ParentID  *int
+	Addressee *string
+	PublishAt time.Time
+	CreatedAt time.Time
+}
+
+// ReadScheduledComments lists comments of the user waiting to be published, the next one first
+func (m *Model) ReadScheduledComments(ctx context.Context, username string) ([]*ScheduledComment, error) {
+	m.log.InfoContext(ctx, "start ReadScheduledComments")
+
+	sqlStatement := `
+		SELECT c.id, c.content, c.parent_id, c.addressee, c.publish_at, c.created_at
+		FROM main.comment c
+		WHERE c.author = ? AND c.status = 'scheduled'
+		ORDER BY c.publish_at ASC, c.id ASC;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, username)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadScheduledComments", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*ScheduledComment, 0)
+	for rows.Next() {
+		c := new(ScheduledComment)
+
+		if err := rows.Scan(&c.ID, &c.Content, &c.ParentID, &c.Addressee, &c.PublishAt, &c.CreatedAt); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadScheduledComments", "error", err)
+			return nil, err
+		}
+
+		comments = append(comments, c)
+	}
+
+	m.log.InfoContext(ctx, "success ReadScheduledComments")
+	return comments, nil
+}
+
+// PublishScheduledComments publishes comments whose publish time has come and announces them to subscribers.
+// It returns how many comments were published.
+func (m *Model) PublishScheduledComments(ctx context.Context) (int, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail PublishScheduledComments", "error", err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: comments are dated when they go out, so they show up as new to everybody
+	sqlStatement := `
+		UPDATE comment
+		SET status = 'visible', created_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE status = 'scheduled' AND publish_at <= CURRENT_TIMESTAMP
+		RETURNING id;
+	`
+
+	rows, err := tx.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail PublishScheduledComments", "error", err)
+		return 0, err
+	}
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			m.log.ErrorContext(ctx, "fail PublishScheduledComments", "error", err)
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	for _, id := range ids {
+		if err := notifySubscribers(ctx, tx, id); err != nil {
+			m.log.ErrorContext(ctx, "fail PublishScheduledComments", "error", err)
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail PublishScheduledComments", "error", err)
+		return 0, err
+	}
+
+	return len(ids), nil
+}
@@ -0,0 +1,244 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+	"github.com/talgat-ruby/interactive-comments-api/internal/spam"
+)
+
+// scoreSpam rates how likely content posted by author is spam. commentID is set when an existing comment is edited,
+// the comment itself then does not count as a duplicate or as recent activity of the author.
+func (m *Model) scoreSpam(ctx context.Context, commentID *int, author *string, content string) (float64, error) {
+	corpus, err := m.readSpamCorpus(ctx, spam.Tokenize(content))
+	if err != nil {
+		return 0, err
+	}
+
+	signals := &spam.Signals{
+		Content: content,
+	}
+
+	sqlStatement := `
+		SELECT COUNT(*)
+		FROM main.comment c
+		WHERE c.content = ? AND c.created_at > datetime('now', '-1 day') AND (? IS NULL OR c.id != ?);
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, content, commentID, commentID).Scan(&signals.Duplicates); err != nil {
+		return 0, err
+	}
+
+	sqlStatement = `
+		SELECT
+			CAST((julianday('now') - julianday(u.created_at)) * 86400 AS INTEGER),
+			(
+				SELECT COUNT(*)
+				FROM main.comment c
+				WHERE c.author = u.username AND c.created_at > datetime('now', '-1 hour') AND (? IS NULL OR c.id != ?)
+			)
+		FROM main.user_ u
+		WHERE u.username = ?;
+	`
+
+	var ageSeconds int64
+	if err := m.db.QueryRowContext(ctx, sqlStatement, commentID, commentID, author).Scan(
+		&ageSeconds,
+		&signals.RecentComments,
+	); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	signals.AccountAge = time.Duration(ageSeconds) * time.Second
+
+	return spam.Score(corpus, signals), nil
+}
+
+// readSpamCorpus loads training document counts and the counts of the given tokens only
+func (m *Model) readSpamCorpus(ctx context.Context, tokens []string) (*spam.Corpus, error) {
+	corpus := &spam.Corpus{
+		Tokens: make(map[string]spam.TokenCount, len(tokens)),
+	}
+
+	sqlStatement := `
+		SELECT
+			COALESCE(SUM(t.label = 'spam'), 0),
+			COALESCE(SUM(t.label = 'ham'), 0)
+		FROM main.spam_training t;
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement).Scan(&corpus.SpamDocs, &corpus.HamDocs); err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return corpus, nil
+	}
+
+	args := make([]any, len(tokens))
+	for i, t := range tokens {
+		args[i] = t
+	}
+
+	sqlStatement = `
+		SELECT
+			t.token,
+			t.spam_count,
+			t.ham_count
+		FROM main.spam_token t
+		WHERE t.token IN (?` + strings.Repeat(", ?", len(tokens)-1) + `);
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token string
+		tc := spam.TokenCount{}
+
+		if err = rows.Scan(&token, &tc.Spam, &tc.Ham); err != nil {
+			return nil, err
+		}
+
+		corpus.Tokens[token] = tc
+	}
+
+	return corpus, nil
+}
+
+type TrainSpamInput struct {
+	CommentID *int
+	Label     constant.SpamLabel
+	Moderator *string
+}
+
+func (m *Model) TrainSpam(ctx context.Context, input *TrainSpamInput) error {
+	m.log.InfoContext(ctx, "start TrainSpam")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var content string
+	if err := tx.QueryRowContext(ctx, `SELECT content FROM comment WHERE id = ?;`, input.CommentID).Scan(&content); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("no record was found, please verify comment id")
+		}
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+
+	// NOTE: relabeling a comment takes back what it was trained with before
+	var prevLabel constant.SpamLabel
+	var prevContent string
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT label, content FROM spam_training WHERE comment_id = ?;`,
+		input.CommentID,
+	).Scan(&prevLabel, &prevContent)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	default:
+		if err := addSpamTokens(ctx, tx, spam.Tokenize(prevContent), prevLabel, -1); err != nil {
+			m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+			return err
+		}
+	}
+
+	if err := addSpamTokens(ctx, tx, spam.Tokenize(content), input.Label, 1); err != nil {
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+
+	sqlStatement := `
+		INSERT INTO spam_training (comment_id, label, content, trained_by)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(comment_id)
+			DO UPDATE SET
+				label = excluded.label,
+				content = excluded.content,
+				trained_by = excluded.trained_by,
+				created_at = CURRENT_TIMESTAMP;
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.CommentID,
+		input.Label,
+		content,
+		input.Moderator,
+	); err != nil {
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+
+	reason := fmt.Sprintf("labeled as %s", input.Label)
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Moderator,
+		Action:     constant.AuditActionModerationSpamLabel,
+		TargetType: constant.AuditTargetComment,
+		TargetID:   strconv.Itoa(*input.CommentID),
+		Reason:     &reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail TrainSpam", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success TrainSpam")
+	return nil
+}
+
+// addSpamTokens adds sign to the label count of every token
+func addSpamTokens(ctx context.Context, tx *sql.Tx, tokens []string, label constant.SpamLabel, sign int) error {
+	sqlStatement := `
+		INSERT INTO spam_token (token, spam_count, ham_count)
+		VALUES (?, ?, ?)
+		ON CONFLICT(token)
+			DO UPDATE SET
+				spam_count = MAX(spam_count + ?, 0),
+				ham_count = MAX(ham_count + ?, 0);
+	`
+
+	spamDelta, hamDelta := 0, 0
+	if label == constant.SpamLabelSpam {
+		spamDelta = sign
+	} else {
+		hamDelta = sign
+	}
+
+	for _, t := range tokens {
+		if _, err := tx.ExecContext(
+			ctx,
+			sqlStatement,
+			t,
+			max(spamDelta, 0),
+			max(hamDelta, 0),
+			spamDelta,
+			hamDelta,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+const topThreadsLimit = 10
+
+type Stats struct {
+	From             time.Time
+	To               time.Time
+	Comments         int
+	CommentsPerDay   []*StatsBucket
+	CommentsPerWeek  []*StatsBucket
+	ActiveCommenters int
+	TopThreads       []*StatsThread
+	Upvotes          int
+	Downvotes        int
+	// AvgFirstReply is the average number of seconds a top-level comment waited for its first reply, nil without replies
+	AvgFirstReply *float64
+	Moderation    *StatsModeration
+}
+
+type StatsBucket struct {
+	// Period is the day, or the monday of the week, formatted as 2006-01-02
+	Period string
+	Count  int
+}
+
+type StatsThread struct {
+	ID      int
+	Author  string
+	Excerpt string
+	Replies int
+	Likes   int
+}
+
+type StatsModeration struct {
+	ReportsOpened    int
+	ReportsUpheld    int
+	ReportsRejected  int
+	ReportsDismissed int
+	// AvgResolution is the average number of seconds a report waited for a moderator, nil without resolved reports
+	AvgResolution    *float64
+	CommentsApproved int
+	CommentsRemoved  int
+}
+
+type ReadStatsInput struct {
+	From time.Time
+	To   time.Time
+}
+
+// ReadStats aggregates activity between From, inclusive, and To, exclusive
+func (m *Model) ReadStats(ctx context.Context, input *ReadStatsInput) (*Stats, error) {
+	m.log.InfoContext(ctx, "start ReadStats")
+
+	from, to := utcTime(&input.From), utcTime(&input.To)
+	s := &Stats{
+		From: input.From,
+		To:   input.To,
+	}
+
+	sqlStatement := `
+		SELECT COUNT(*), COUNT(DISTINCT c.author)
+		FROM main.comment c
+		WHERE c.status != 'scheduled' AND c.created_at >= ? AND c.created_at < ?;
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, from, to).Scan(&s.Comments, &s.ActiveCommenters); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	var err error
+	if s.CommentsPerDay, err = m.readStatsBuckets(ctx, `date(c.created_at)`, from, to); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	// NOTE: weeks start on the monday on or before the day
+	if s.CommentsPerWeek, err = m.readStatsBuckets(ctx, `date(c.created_at, '-6 days', 'weekday 1')`, from, to); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	if s.TopThreads, err = m.readStatsTopThreads(ctx, from, to); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	sqlStatement = `
+		SELECT COALESCE(SUM(l.rate > 0), 0), COALESCE(SUM(l.rate < 0), 0)
+		FROM main.like_ l
+		WHERE l.created_at >= ? AND l.created_at < ?;
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, from, to).Scan(&s.Upvotes, &s.Downvotes); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	sqlStatement = `
+		SELECT AVG(first_reply)
+		FROM (
+			SELECT (julianday(MIN(r.created_at)) - julianday(p.created_at)) * 86400 AS first_reply
+			FROM main.comment p
+			JOIN main.comment r ON r.parent_id = p.id AND r.status != 'scheduled'
+			WHERE p.parent_id IS NULL AND p.status != 'scheduled' AND p.created_at >= ? AND p.created_at < ?
+			GROUP BY p.id
+		);
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, from, to).Scan(&s.AvgFirstReply); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	if s.Moderation, err = m.readStatsModeration(ctx, from, to); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadStats", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadStats")
+	return s, nil
+}
+
+// readStatsBuckets counts comments grouped by period, an expression of c.created_at
+func (m *Model) readStatsBuckets(ctx context.Context, period string, from *string, to *string) ([]*StatsBucket, error) {
+	sqlStatement := `
+		SELECT ` + period + ` AS period, COUNT(*)
+		FROM main.comment c
+		WHERE c.status != 'scheduled' AND c.created_at >= ? AND c.created_at < ?
+		GROUP BY period
+		ORDER BY period ASC;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]*StatsBucket, 0)
+	for rows.Next() {
+		b := new(StatsBucket)
+
+		if err := rows.Scan(&b.Period, &b.Count); err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, b)
+	}
+
+	return buckets, nil
+}
+
+// readStatsTopThreads returns visible threads with the most replies posted in the range
+func (m *Model) readStatsTopThreads(ctx context.Context, from *string, to *string) ([]*StatsThread, error) {
+	sqlStatement := `
+		SELECT
+			p.id,
+			p.author,
+			p.content,
+			COUNT(r.id) AS replies,
+			(SELECT COALESCE(SUM(l.rate), 0) FROM main.like_ l WHERE l.comment_id = p.id) AS likes
+		FROM main.comment p
+		JOIN main.comment r ON r.parent_id = p.id AND r.status = 'visible' AND r.created_at >= ? AND r.created_at < ?
+		WHERE p.parent_id IS NULL AND p.status = 'visible'
+		GROUP BY p.id
+		ORDER BY replies DESC, likes DESC, p.id ASC
+		LIMIT ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, from, to, topThreadsLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	threads := make([]*StatsThread, 0)
+	for rows.Next() {
+		t := new(StatsThread)
+		var content string
+
+		if err := rows.Scan(&t.ID, &t.Author, &content, &t.Replies, &t.Likes); err != nil {
+			return nil, err
+		}
+
+		t.Excerpt = excerpt(content)
+		threads = append(threads, t)
+	}
+
+	return threads, nil
+}
+
+// readStatsModeration counts reports opened and resolved in the range, and moderator decisions from the audit log
+func (m *Model) readStatsModeration(ctx context.Context, from *string, to *string) (*StatsModeration, error) {
+	sm := new(StatsModeration)
+
+	sqlStatement := `
+		SELECT
+			(SELECT COUNT(*) FROM main.report r WHERE r.created_at >= ? AND r.created_at < ?),
+			COALESCE(SUM(r.status = 'upheld'), 0),
+			COALESCE(SUM(r.status = 'rejected'), 0),
+			COALESCE(SUM(r.status = 'dismissed'), 0),
+			AVG((julianday(r.resolved_at) - julianday(r.created_at)) * 86400)
+		FROM main.report r
+		WHERE r.resolved_at >= ? AND r.resolved_at < ?;
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, from, to, from, to).Scan(
+		&sm.ReportsOpened,
+		&sm.ReportsUpheld,
+		&sm.ReportsRejected,
+		&sm.ReportsDismissed,
+		&sm.AvgResolution,
+	); err != nil {
+		return nil, err
+	}
+
+	sqlStatement = `
+		SELECT
+			COALESCE(SUM(a.action = 'moderation.approve'), 0),
+			COALESCE(SUM(a.action = 'moderation.remove'), 0)
+		FROM main.audit_log a
+		WHERE a.created_at >= ? AND a.created_at < ?;
+	`
+
+	if err := m.db.QueryRowContext(ctx, sqlStatement, from, to).Scan(&sm.CommentsApproved, &sm.CommentsRemoved); err != nil {
+		return nil, err
+	}
+
+	return sm, nil
+}
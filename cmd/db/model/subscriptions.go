@@ -0,0 +1,141 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type SubscriptionInput struct {
+	Username *string
+	// CommentID is of any comment in the thread, subscriptions are kept per top-level comment
+	CommentID *int
+}
+
+// CreateSubscription follows the thread of the comment
+func (m *Model) CreateSubscription(ctx context.Context, input *SubscriptionInput) error {
+	m.log.InfoContext(ctx, "start CreateSubscription")
+
+	sqlStatement := `
+		INSERT INTO subscription (username, comment_id)
+		SELECT u.username, COALESCE(c.parent_id, c.id)
+		FROM comment c
+		JOIN user_ u ON u.username = ?
+		WHERE c.id = ? AND c.status = 'visible'
+		ON CONFLICT(username, comment_id) DO NOTHING;
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.Username, input.CommentID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail CreateSubscription", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was inserted, please verify comment id or that you have not done it already")
+	}
+
+	m.log.InfoContext(ctx, "success CreateSubscription")
+	return nil
+}
+
+// DeleteSubscription stops following the thread of the comment
+func (m *Model) DeleteSubscription(ctx context.Context, input *SubscriptionInput) error {
+	m.log.InfoContext(ctx, "start DeleteSubscription")
+
+	sqlStatement := `
+		DELETE FROM subscription
+		WHERE username = ? AND comment_id = (SELECT COALESCE(c.parent_id, c.id) FROM comment c WHERE c.id = ?);
+	`
+
+	res, err := m.db.ExecContext(ctx, sqlStatement, input.Username, input.CommentID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DeleteSubscription", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was deleted, please verify you follow the thread")
+	}
+
+	m.log.InfoContext(ctx, "success DeleteSubscription")
+	return nil
+}
+
+type Subscription struct {
+	CommentID    int
+	Author       string
+	Excerpt      string
+	SubscribedAt time.Time
+}
+
+type ReadSubscriptionsInput struct {
+	Username string
+	Limit    int
+	Offset   int
+}
+
+// ReadSubscriptions lists threads the user follows, latest subscriptions first
+func (m *Model) ReadSubscriptions(ctx context.Context, input *ReadSubscriptionsInput) ([]*Subscription, int, error) {
+	m.log.InfoContext(ctx, "start ReadSubscriptions")
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.subscription s JOIN main.comment c ON s.comment_id = c.id WHERE s.username = ? AND c.status = 'visible'`,
+		input.Username,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadSubscriptions", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT c.id, c.author, c.content, s.created_at
+		FROM main.subscription s
+		JOIN main.comment c ON s.comment_id = c.id
+		WHERE s.username = ? AND c.status = 'visible'
+		ORDER BY s.created_at DESC, c.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, input.Username, input.Limit, input.Offset)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadSubscriptions", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*Subscription, 0)
+	for rows.Next() {
+		s := new(Subscription)
+		var content string
+
+		if err := rows.Scan(&s.CommentID, &s.Author, &content, &s.SubscribedAt); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadSubscriptions", "error", err)
+			return nil, 0, err
+		}
+
+		s.Excerpt = excerpt(content)
+		subscriptions = append(subscriptions, s)
+	}
+
+	m.log.InfoContext(ctx, "success ReadSubscriptions")
+	return subscriptions, total, nil
+}
+
+// subscribe makes the author of the comment follow its thread
+func subscribe(ctx context.Context, tx *sql.Tx, commentID int64) error {
+	sqlStatement := `
+		INSERT INTO subscription (username, comment_id)
+		SELECT c.author, COALESCE(c.parent_id, c.id) FROM comment c WHERE c.id = ?
+		ON CONFLICT(username, comment_id) DO NOTHING;
+	`
+
+	_, err := tx.ExecContext(ctx, sqlStatement, commentID)
+	return err
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type SetThreadFlagInput struct {
+	CommentID *int
+	Moderator *string
+	Flag      constant.ThreadFlag
+	Value     bool
+	Reason    *string
+}
+
+// SetThreadFlag pins, features or locks a top-level comment, or takes it back
+func (m *Model) SetThreadFlag(ctx context.Context, input *SetThreadFlagInput) error {
+	m.log.InfoContext(ctx, "start SetThreadFlag")
+
+	column, action, err := threadFlagColumn(input.Flag, input.Value)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail SetThreadFlag", "error", err)
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail SetThreadFlag", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := fmt.Sprintf(`
+		UPDATE comment
+		SET %s = ?
+		WHERE id = ? AND parent_id IS NULL AND status != 'removed';
+	`, column)
+
+	res, err := tx.ExecContext(ctx, sqlStatement, input.Value, input.CommentID)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail SetThreadFlag", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify comment id is of a top-level comment")
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Moderator,
+		Action:     action,
+		TargetType: constant.AuditTargetComment,
+		TargetID:   strconv.Itoa(*input.CommentID),
+		Reason:     input.Reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail SetThreadFlag", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail SetThreadFlag", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success SetThreadFlag")
+	return nil
+}
+
+// threadFlagColumn returns the column a flag is stored in and the audit action for setting it to value
+func threadFlagColumn(flag constant.ThreadFlag, value bool) (string, constant.AuditAction, error) {
+	switch {
+	case flag == constant.ThreadFlagPin && value:
+		return "pinned", constant.AuditActionThreadPin, nil
+	case flag == constant.ThreadFlagPin:
+		return "pinned", constant.AuditActionThreadUnpin, nil
+	case flag == constant.ThreadFlagFeature && value:
+		return "featured", constant.AuditActionThreadFeature, nil
+	case flag == constant.ThreadFlagFeature:
+		return "featured", constant.AuditActionThreadUnfeature, nil
+	case flag == constant.ThreadFlagLock && value:
+		return "locked", constant.AuditActionThreadLock, nil
+	case flag == constant.ThreadFlagLock:
+		return "locked", constant.AuditActionThreadUnlock, nil
+	default:
+		return "", "", fmt.Errorf("unknown thread flag %q", flag)
+	}
+}
+
+// isThreadLocked checks whether the thread of the top-level comment is locked
+func (m *Model) isThreadLocked(ctx context.Context, parentID *int) (bool, error) {
+	sqlStatement := `
+		SELECT c.locked FROM comment c WHERE c.id = ?;
+	`
+
+	var locked bool
+	if err := m.db.QueryRowContext(ctx, sqlStatement, parentID).Scan(&locked); err != nil {
+		// NOTE: a missing parent is reported by the insert
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return locked, nil
+}
@@ -0,0 +1,210 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type User struct {
+	Username  string
+	Role      constant.Role
+	CreatedAt time.Time
+	Ban       *Ban
+}
+
+// Ban is a sanction which is in force, expired ones are never loaded
+type Ban struct {
+	Status constant.BanStatus
+	Until  *time.Time
+	Reason *string
+}
+
+// WriteForbidden returns why the user may not post, edit or vote, or nil when they may.
+// Shadow-banned users may write, nobody else sees it anyway.
+func (u *User) WriteForbidden() error {
+	if u.Ban == nil {
+		return nil
+	}
+
+	switch u.Ban.Status {
+	case constant.BanStatusSuspended:
+		if u.Ban.Until != nil {
+			return fmt.Errorf("your account is suspended until %s", u.Ban.Until.UTC().Format(time.DateTime))
+		}
+		return fmt.Errorf("your account is suspended")
+	case constant.BanStatusBanned:
+		return fmt.Errorf("your account is banned")
+	}
+
+	return nil
+}
+
+// scanUser scans username, role, created_at and active_ban status, until, reason columns
+func scanUser(row interface{ Scan(dest ...any) error }) (*User, error) {
+	u := new(User)
+
+	var (
+		banStatus *constant.BanStatus
+		banUntil  *time.Time
+		banReason *string
+	)
+
+	if err := row.Scan(
+		&u.Username,
+		&u.Role,
+		&u.CreatedAt,
+		&banStatus,
+		&banUntil,
+		&banReason,
+	); err != nil {
+		return nil, err
+	}
+
+	if banStatus != nil {
+		u.Ban = &Ban{
+			Status: *banStatus,
+			Until:  banUntil,
+			Reason: banReason,
+		}
+	}
+
+	return u, nil
+}
+
+func (m *Model) ReadUser(ctx context.Context, username string) (*User, error) {
+	m.log.InfoContext(ctx, "start ReadUser")
+
+	sqlStatement := `
+		SELECT
+			u.username,
+			u.role,
+			u.created_at,
+			ab.status,
+			ab.until,
+			ab.reason
+		FROM main.user_ u
+		LEFT JOIN main.active_ban ab ON u.username = ab.username
+		WHERE u.username = ?;
+	`
+
+	u, err := scanUser(m.db.QueryRowContext(ctx, sqlStatement, username))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("user was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ReadUser", "error", err)
+		return nil, err
+	}
+
+	m.log.InfoContext(ctx, "success ReadUser")
+	return u, nil
+}
+
+func (m *Model) ReadUsers(ctx context.Context) ([]*User, error) {
+	m.log.InfoContext(ctx, "start ReadUsers")
+
+	sqlStatement := `
+		SELECT
+			u.username,
+			u.role,
+			u.created_at,
+			ab.status,
+			ab.until,
+			ab.reason
+		FROM main.user_ u
+		LEFT JOIN main.active_ban ab ON u.username = ab.username
+		ORDER BY u.username;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadUsers", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			m.log.ErrorContext(ctx, "fail ReadUsers", "error", err)
+			return nil, err
+		}
+
+		users = append(users, u)
+	}
+
+	m.log.InfoContext(ctx, "success ReadUsers")
+	return users, nil
+}
+
+type UpdateUserRoleInput struct {
+	Username *string
+	Role     constant.Role
+	Actor    *string
+	Reason   *string
+}
+
+func (m *Model) UpdateUserRole(ctx context.Context, input *UpdateUserRoleInput) error {
+	m.log.InfoContext(ctx, "start UpdateUserRole")
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateUserRole", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlStatement := `
+		UPDATE user_
+		SET role = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE username = ? AND username != ?;
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		sqlStatement,
+		input.Role,
+		input.Username,
+		input.Actor,
+	)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateUserRole", "error", err)
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("no record was update, please verify username, you cannot change your own role")
+	}
+
+	reason := fmt.Sprintf("role set to %s", input.Role)
+	if input.Reason != nil {
+		reason = fmt.Sprintf("%s: %s", reason, *input.Reason)
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Actor,
+		Action:     constant.AuditActionUserRoleChange,
+		TargetType: constant.AuditTargetUser,
+		TargetID:   *input.Username,
+		Reason:     &reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateUserRole", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail UpdateUserRole", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success UpdateUserRole")
+	return nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// batchSize is the most ids bound to a single IN list, well below the sqlite limit of bound parameters
+const batchSize = 500
+
+// batches splits ids into consecutive slices of at most size ids
+func batches[T any](ids []T, size int) [][]T {
+	res := make([][]T, 0, (len(ids)+size-1)/size)
+	for len(ids) > size {
+		res = append(res, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		res = append(res, ids)
+	}
+
+	return res
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// utcTime converts t to the UTC format sqlite CURRENT_TIMESTAMP columns are stored in
+func utcTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	s := t.UTC().Format(time.DateTime)
+	return &s
+}
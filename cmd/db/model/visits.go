@@ -0,0 +1,38 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// markThreadsSeen records that the user has seen the threads of the top-level comments as of seenAt,
+// the time they were read at, so replies posted while the response was built still count as new.
+func (m *Model) markThreadsSeen(ctx context.Context, username string, threadIDs []*int, seenAt time.Time) error {
+	if username == "" || len(threadIDs) == 0 {
+		return nil
+	}
+
+	// NOTE: timestamps have second precision, comments of the same second are shown as new once more
+	seenAt = seenAt.Truncate(time.Second).Add(-time.Second)
+
+	args := make([]any, 0, len(threadIDs)+2)
+	args = append(args, utcTime(&seenAt))
+	for _, id := range threadIDs {
+		args = append(args, id)
+	}
+	args = append(args, username)
+
+	sqlStatement := fmt.Sprintf(`
+		INSERT INTO thread_visit (username, comment_id, seen_at)
+		SELECT u.username, c.id, ?
+		FROM comment c
+		JOIN user_ u
+		WHERE c.id IN (%s) AND u.username = ?
+		ON CONFLICT(username, comment_id) DO UPDATE SET seen_at = MAX(seen_at, excluded.seen_at);
+	`, strings.TrimSuffix(strings.Repeat("?,", len(threadIDs)), ","))
+
+	_, err := m.db.ExecContext(ctx, sqlStatement, args...)
+	return err
+}
@@ -0,0 +1,480 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type VoteRing struct {
+	ID      int
+	Members []string
+	// Votes is the number of upvotes the members gave each other within the analyzed window
+	Votes      int
+	Status     constant.VoteRingStatus
+	DetectedAt time.Time
+	ResolvedBy *string
+	ResolvedAt *time.Time
+}
+
+// DetectVoteRings flags groups of users who each gave another member at least VoteRingVotes upvotes and got as many back
+// within VoteRingWindow. Pending rings whose members all belong to a ring found now are merged into it.
+// It returns the number of pending rings found.
+func (m *Model) DetectVoteRings(ctx context.Context) (int, error) {
+	m.log.InfoContext(ctx, "start DetectVoteRings")
+
+	since := time.Now().Add(-m.conf.VoteRingWindow)
+
+	sqlStatement := `
+		WITH vote AS (
+			SELECT l.author AS voter, c.author AS recipient, COUNT(*) AS n
+			FROM like_ l
+			JOIN comment c ON l.comment_id = c.id
+			WHERE l.rate > 0 AND l.author != c.author AND l.created_at >= ?
+			GROUP BY l.author, c.author
+		)
+		SELECT a.voter, a.recipient, a.n + b.n
+		FROM vote a
+		JOIN vote b ON a.voter = b.recipient AND a.recipient = b.voter
+		WHERE a.voter < a.recipient AND a.n >= ? AND b.n >= ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, utcTime(&since), m.conf.VoteRingVotes, m.conf.VoteRingVotes)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+		return 0, err
+	}
+	defer rows.Close()
+
+	// NOTE: pairs which share a member belong to the same ring
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(u string) string {
+		if p, ok := parent[u]; ok && p != u {
+			parent[u] = find(p)
+			return parent[u]
+		}
+		parent[u] = u
+		return u
+	}
+
+	type pair struct {
+		a, b  string
+		votes int
+	}
+	pairs := make([]pair, 0)
+	for rows.Next() {
+		var a, b string
+		var votes int
+
+		if err := rows.Scan(&a, &b, &votes); err != nil {
+			m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+			return 0, err
+		}
+
+		parent[find(a)] = find(b)
+		pairs = append(pairs, pair{a, b, votes})
+	}
+	if err := rows.Err(); err != nil {
+		m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+		return 0, err
+	}
+
+	members := make(map[string][]string)
+	for u := range parent {
+		root := find(u)
+		members[root] = append(members[root], u)
+	}
+
+	votes := make(map[string]int)
+	ringPairs := make(map[string][]pair)
+	for _, p := range pairs {
+		root := find(p.a)
+		votes[root] += p.votes
+		ringPairs[root] = append(ringPairs[root], p)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// NOTE: a nullified ring which is found again voted anew, so it is pending again
+	sqlStatement = `
+		INSERT INTO vote_ring (key, votes) VALUES (?, ?)
+		ON CONFLICT(key) DO UPDATE SET
+			votes = excluded.votes,
+			detected_at = CURRENT_TIMESTAMP,
+			status = CASE WHEN status = 'nullified' THEN 'pending' ELSE status END,
+			resolved_by = CASE WHEN status = 'nullified' THEN NULL ELSE resolved_by END,
+			resolved_at = CASE WHEN status = 'nullified' THEN NULL ELSE resolved_at END
+		RETURNING id, status;
+	`
+
+	var pending int
+	for root, us := range members {
+		sort.Strings(us)
+
+		var id int
+		var status constant.VoteRingStatus
+		if err := tx.QueryRowContext(ctx, sqlStatement, strings.Join(us, ","), votes[root]).Scan(&id, &status); err != nil {
+			m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+			return 0, err
+		}
+
+		for _, u := range us {
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT OR IGNORE INTO vote_ring_member (ring_id, username) VALUES (?, ?);`,
+				id,
+				u,
+			); err != nil {
+				m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+				return 0, err
+			}
+		}
+
+		for _, p := range ringPairs[root] {
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT OR IGNORE INTO vote_ring_pair (ring_id, voter_a, voter_b) VALUES (?, ?, ?);`,
+				id,
+				p.a,
+				p.b,
+			); err != nil {
+				m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+				return 0, err
+			}
+		}
+
+		if status == constant.VoteRingStatusPending {
+			if err := mergeVoteRings(ctx, tx, id, us); err != nil {
+				m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+				return 0, err
+			}
+
+			pending++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail DetectVoteRings", "error", err)
+		return 0, err
+	}
+
+	m.log.InfoContext(ctx, "success DetectVoteRings")
+	return pending, nil
+}
+
+// mergeVoteRings moves the pairs of other pending rings made up of members only into ring id and drops those rings,
+// so a group which grew is reviewed once.
+func mergeVoteRings(ctx context.Context, tx *sql.Tx, id int, members []string) error {
+	args := make([]any, 0, len(members)+1)
+	args = append(args, id)
+	for _, u := range members {
+		args = append(args, u)
+	}
+
+	sqlStatement := fmt.Sprintf(`
+		SELECT vr.id
+		FROM vote_ring vr
+		WHERE vr.id != ? AND vr.status = 'pending' AND NOT EXISTS (
+			SELECT * FROM vote_ring_member vrm WHERE vrm.ring_id = vr.id AND vrm.username NOT IN (%s)
+		);
+	`, strings.TrimSuffix(strings.Repeat("?,", len(members)), ","))
+
+	rows, err := tx.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var subID int
+		if err := rows.Scan(&subID); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, subID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, subID := range ids {
+		sqlStatement = `
+			INSERT OR IGNORE INTO vote_ring_pair (ring_id, voter_a, voter_b)
+			SELECT ?, vrp.voter_a, vrp.voter_b FROM vote_ring_pair vrp WHERE vrp.ring_id = ?;
+		`
+
+		if _, err := tx.ExecContext(ctx, sqlStatement, id, subID); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM vote_ring WHERE id = ?;`, subID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type ReadVoteRingsInput struct {
+	// Status keeps rings of the status only, all of them when empty
+	Status constant.VoteRingStatus
+	Limit  int
+	Offset int
+}
+
+func (m *Model) ReadVoteRings(ctx context.Context, input *ReadVoteRingsInput) ([]*VoteRing, int, error) {
+	m.log.InfoContext(ctx, "start ReadVoteRings")
+
+	var total int
+	if err := m.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM main.vote_ring vr WHERE ? = '' OR vr.status = ?`,
+		input.Status,
+		input.Status,
+	).Scan(&total); err != nil {
+		m.log.ErrorContext(ctx, "fail ReadVoteRings", "error", err)
+		return nil, 0, err
+	}
+
+	sqlStatement := `
+		SELECT
+			vr.id,
+			vr.votes,
+			vr.status,
+			vr.detected_at,
+			vr.resolved_by,
+			vr.resolved_at
+		FROM main.vote_ring vr
+		WHERE ? = '' OR vr.status = ?
+		ORDER BY vr.detected_at DESC, vr.id DESC
+		LIMIT ? OFFSET ?;
+	`
+
+	rows, err := m.db.QueryContext(ctx, sqlStatement, input.Status, input.Status, input.Limit, input.Offset)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadVoteRings", "error", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	rings := make([]*VoteRing, 0)
+	mRings := make(map[int]*VoteRing)
+	for rows.Next() {
+		r := new(VoteRing)
+
+		if err = rows.Scan(
+			&r.ID,
+			&r.Votes,
+			&r.Status,
+			&r.DetectedAt,
+			&r.ResolvedBy,
+			&r.ResolvedAt,
+		); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadVoteRings", "error", err)
+			return nil, 0, err
+		}
+
+		r.Members = make([]string, 0)
+		rings = append(rings, r)
+		mRings[r.ID] = r
+	}
+
+	if len(rings) == 0 {
+		m.log.InfoContext(ctx, "success ReadVoteRings")
+		return rings, total, nil
+	}
+
+	args := make([]any, len(rings))
+	for i, r := range rings {
+		args[i] = r.ID
+	}
+
+	sqlStatement = fmt.Sprintf(`
+		SELECT vrm.ring_id, vrm.username
+		FROM main.vote_ring_member vrm
+		WHERE vrm.ring_id IN (%s)
+		ORDER BY vrm.username ASC;
+	`, strings.TrimSuffix(strings.Repeat("?,", len(rings)), ","))
+
+	memberRows, err := m.db.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ReadVoteRings", "error", err)
+		return nil, 0, err
+	}
+	defer memberRows.Close()
+
+	for memberRows.Next() {
+		var ringID int
+		var username string
+
+		if err := memberRows.Scan(&ringID, &username); err != nil {
+			m.log.ErrorContext(ctx, "fail ReadVoteRings", "error", err)
+			return nil, 0, err
+		}
+
+		mRings[ringID].Members = append(mRings[ringID].Members, username)
+	}
+
+	m.log.InfoContext(ctx, "success ReadVoteRings")
+	return rings, total, nil
+}
+
+type ResolveVoteRingInput struct {
+	ID        *int
+	Moderator *string
+	Action    constant.VoteRingAction
+	Reason    *string
+}
+
+// ResolveVoteRing closes a pending vote ring. Nullifying removes every upvote exchanged within its reciprocal pairs and
+// takes back the karma the members earned with it.
+func (m *Model) ResolveVoteRing(ctx context.Context, input *ResolveVoteRingInput) error {
+	m.log.InfoContext(ctx, "start ResolveVoteRing")
+
+	status, auditAction, err := voteRingActionOutcome(input.Action)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var current constant.VoteRingStatus
+	if err := tx.QueryRowContext(ctx, `SELECT vr.status FROM vote_ring vr WHERE vr.id = ?;`, input.ID).Scan(&current); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("vote ring was not found")
+		}
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	} else if current != constant.VoteRingStatusPending {
+		return fmt.Errorf("vote ring is already resolved")
+	}
+
+	if status == constant.VoteRingStatusNullified {
+		if err := m.nullifyVoteRing(ctx, tx, input.ID); err != nil {
+			m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+			return err
+		}
+	}
+
+	sqlStatement := `
+		UPDATE vote_ring
+		SET status = ?, resolved_by = ?, resolved_at = CURRENT_TIMESTAMP
+		WHERE id = ?;
+	`
+
+	if _, err := tx.ExecContext(ctx, sqlStatement, status, input.Moderator, input.ID); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	}
+
+	if err := m.insertAuditLog(ctx, tx, &auditLogEntry{
+		Actor:      input.Moderator,
+		Action:     auditAction,
+		TargetType: constant.AuditTargetVoteRing,
+		TargetID:   strconv.Itoa(*input.ID),
+		Reason:     input.Reason,
+	}); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		m.log.ErrorContext(ctx, "fail ResolveVoteRing", "error", err)
+		return err
+	}
+
+	m.log.InfoContext(ctx, "success ResolveVoteRing")
+	return nil
+}
+
+// nullifyVoteRing deletes the upvotes exchanged within the reciprocal pairs of the ring during the VoteRingWindow
+// before it was detected, members of the ring who only share a partner keep the votes they gave each other
+func (m *Model) nullifyVoteRing(ctx context.Context, tx *sql.Tx, ringID *int) error {
+	var detectedAt time.Time
+	if err := tx.QueryRowContext(ctx, `SELECT vr.detected_at FROM vote_ring vr WHERE vr.id = ?;`, ringID).Scan(&detectedAt); err != nil {
+		return err
+	}
+	since := detectedAt.Add(-m.conf.VoteRingWindow)
+
+	sqlStatement := `
+		SELECT l.id, l.author, l.comment_id, l.rate
+		FROM like_ l
+		JOIN comment c ON l.comment_id = c.id
+		WHERE l.rate > 0 AND l.created_at >= ? AND l.created_at <= ? AND EXISTS (
+			SELECT * FROM vote_ring_pair vrp
+			WHERE vrp.ring_id = ? AND (
+				(vrp.voter_a = l.author AND vrp.voter_b = c.author) OR
+				(vrp.voter_b = l.author AND vrp.voter_a = c.author)
+			)
+		);
+	`
+
+	rows, err := tx.QueryContext(ctx, sqlStatement, utcTime(&since), utcTime(&detectedAt), ringID)
+	if err != nil {
+		return err
+	}
+
+	type vote struct {
+		id        int
+		author    string
+		commentID int
+		rate      int
+	}
+	votes := make([]vote, 0)
+	for rows.Next() {
+		var v vote
+		if err := rows.Scan(&v.id, &v.author, &v.commentID, &v.rate); err != nil {
+			rows.Close()
+			return err
+		}
+		votes = append(votes, v)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, v := range votes {
+		if err := m.applyKarmaVote(ctx, tx, &v.author, &v.commentID, v.rate, 0); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM like_ WHERE id = ?;`, v.id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// voteRingActionOutcome returns the status a vote ring action leaves the ring in and the audit action recorded for it
+func voteRingActionOutcome(action constant.VoteRingAction) (constant.VoteRingStatus, constant.AuditAction, error) {
+	switch action {
+	case constant.VoteRingActionNullify:
+		return constant.VoteRingStatusNullified, constant.AuditActionVoteRingNullify, nil
+	case constant.VoteRingActionDismiss:
+		return constant.VoteRingStatusDismissed, constant.AuditActionVoteRingDismiss, nil
+	default:
+		return "", "", fmt.Errorf("unknown vote ring action %q", action)
+	}
+}
@@ -2,14 +2,66 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/talgat-ruby/interactive-comments-api/cmd/db/model"
+	"github.com/talgat-ruby/interactive-comments-api/internal/ratelimit"
 )
 
 type DB interface {
-	ReadComments(ctx context.Context, username string) ([]*model.Comment, error)
+	ReadComments(ctx context.Context, input *model.ReadCommentsInput) ([]*model.Comment, error)
+	ReadComment(ctx context.Context, input *model.ReadCommentInput) (*model.Comment, error)
 	CreateComment(ctx context.Context, input *model.CreateCommentInput) error
 	UpdateComment(ctx context.Context, input *model.UpdateCommentInput) error
 	DeleteComment(ctx context.Context, input *model.DeleteCommentInput) error
+	ReadScheduledComments(ctx context.Context, username string) ([]*model.ScheduledComment, error)
+	PublishScheduledComments(ctx context.Context) (int, error)
+	ReadDraft(ctx context.Context, username string, threadKey string) (*model.Draft, error)
+	UpsertDraft(ctx context.Context, input *model.UpsertDraftInput) error
+	DeleteDraft(ctx context.Context, username *string, threadKey string) error
+	AcceptAnswer(ctx context.Context, input *model.AcceptAnswerInput) error
+	UnacceptAnswer(ctx context.Context, input *model.AcceptAnswerInput) error
+	CreateSubscription(ctx context.Context, input *model.SubscriptionInput) error
+	DeleteSubscription(ctx context.Context, input *model.SubscriptionInput) error
+	ReadSubscriptions(ctx context.Context, input *model.ReadSubscriptionsInput) ([]*model.Subscription, int, error)
+	CreateBookmark(ctx context.Context, input *model.BookmarkInput) error
+	DeleteBookmark(ctx context.Context, input *model.BookmarkInput) error
+	ReadBookmarks(ctx context.Context, input *model.ReadBookmarksInput) ([]*model.Bookmark, int, error)
+	ReadNotifications(ctx context.Context, input *model.ReadNotificationsInput) ([]*model.Notification, int, error)
+	MarkNotificationsRead(ctx context.Context, username *string) error
 	UpsertLike(ctx context.Context, input *model.UpsertLikeInput) error
+	VotePoll(ctx context.Context, input *model.VotePollInput) error
+	CreateReport(ctx context.Context, input *model.CreateReportInput) error
+	ReadModerationQueue(ctx context.Context) ([]*model.ModerationQueueItem, error)
+	ResolveModeration(ctx context.Context, input *model.ResolveModerationInput) error
+	DetectVoteRings(ctx context.Context) (int, error)
+	ReadVoteRings(ctx context.Context, input *model.ReadVoteRingsInput) ([]*model.VoteRing, int, error)
+	ResolveVoteRing(ctx context.Context, input *model.ResolveVoteRingInput) error
+	TrainSpam(ctx context.Context, input *model.TrainSpamInput) error
+	ReadUser(ctx context.Context, username string) (*model.User, error)
+	ReadUsers(ctx context.Context) ([]*model.User, error)
+	UpdateUserRole(ctx context.Context, input *model.UpdateUserRoleInput) error
+	ReadUserProfile(ctx context.Context, username string, viewer string) (*model.UserProfile, error)
+	UpdateUserProfile(ctx context.Context, input *model.UpdateUserProfileInput) error
+	ReadUserComments(ctx context.Context, input *model.ReadUserCommentsInput) ([]*model.UserComment, int, error)
+	ReadUserActivity(ctx context.Context, input *model.ReadUserActivityInput) ([]*model.ActivityItem, int, error)
+	CreateAttachment(ctx context.Context, input *model.CreateAttachmentInput) (int, error)
+	ReadAttachment(ctx context.Context, id int, viewer string) (*model.Attachment, error)
+	ReadOrphanAttachments(ctx context.Context, ttl time.Duration) ([]*model.Attachment, error)
+	DeleteOrphanAttachment(ctx context.Context, id int) error
+	ReadAvatar(ctx context.Context, username string) (*model.Avatar, error)
+	UpsertAvatar(ctx context.Context, input *model.UpsertAvatarInput) error
+	SetThreadFlag(ctx context.Context, input *model.SetThreadFlagInput) error
+	BanUser(ctx context.Context, input *model.BanUserInput) error
+	UnbanUser(ctx context.Context, input *model.UnbanUserInput) error
+	ReadAuditLogs(ctx context.Context, input *model.ReadAuditLogsInput) ([]*model.AuditLog, int, error)
+	ReadStats(ctx context.Context, input *model.ReadStatsInput) (*model.Stats, error)
+	ReadFilterRules(ctx context.Context) ([]*model.FilterRule, error)
+	CreateFilterRule(ctx context.Context, input *model.CreateFilterRuleInput) error
+	UpdateFilterRule(ctx context.Context, input *model.UpdateFilterRuleInput) error
+	DeleteFilterRule(ctx context.Context, input *model.DeleteFilterRuleInput) error
+	CreateUserBlock(ctx context.Context, input *model.CreateUserBlockInput) error
+	DeleteUserBlock(ctx context.Context, input *model.DeleteUserBlockInput) error
+	ReadRateLimitBuckets(ctx context.Context) (map[string]ratelimit.Bucket, error)
+	SaveRateLimitBuckets(ctx context.Context, buckets map[string]ratelimit.Bucket) error
 }
@@ -0,0 +1,51 @@
+package configs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+// MarkdownFeatures is a comma separated list of enabled markdown syntax, e.g. "emphasis,links".
+// An empty list leaves only paragraphs and line breaks.
+type MarkdownFeatures []constant.MarkdownFeature
+
+func (f *MarkdownFeatures) EnvDecode(val string) error {
+	return f.Set(val)
+}
+
+func (f *MarkdownFeatures) Set(val string) error {
+	features := make(MarkdownFeatures, 0)
+
+	for _, s := range strings.Split(val, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		feature := constant.MarkdownFeature(strings.ToLower(s))
+		if !slices.Contains(constant.MarkdownFeatures, feature) {
+			return fmt.Errorf("markdown feature %q is unknown", s)
+		}
+		features = append(features, feature)
+	}
+
+	*f = features
+
+	return nil
+}
+
+func (f *MarkdownFeatures) String() string {
+	if f == nil {
+		return ""
+	}
+
+	strs := make([]string, len(*f))
+	for i, feature := range *f {
+		strs[i] = string(feature)
+	}
+
+	return strings.Join(strs, ",")
+}
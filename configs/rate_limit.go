@@ -0,0 +1,49 @@
+package configs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is a request budget written as "<requests>/<duration>", e.g. "10/1m".
+// It is decoded from environment variables and can be used as a flag value.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (r *RateLimit) EnvDecode(val string) error {
+	return r.Set(val)
+}
+
+func (r *RateLimit) Set(val string) error {
+	requests, per, ok := strings.Cut(val, "/")
+	if !ok {
+		return fmt.Errorf("rate limit %q must look like <requests>/<duration>", val)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(requests))
+	if err != nil || n <= 0 {
+		return fmt.Errorf("rate limit %q has invalid number of requests", val)
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(per))
+	if err != nil || d <= 0 {
+		return fmt.Errorf("rate limit %q has invalid duration", val)
+	}
+
+	r.Requests = n
+	r.Per = d
+
+	return nil
+}
+
+func (r *RateLimit) String() string {
+	if r == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%s", r.Requests, r.Per)
+}
@@ -11,10 +11,20 @@ import (
 )
 
 type ApiConfig struct {
-	Env         constant.Environment
-	Host        string        `env:"HOST,default=localhost"`
-	Port        int           `env:"PORT,default=8081"`
-	IdleTimeout time.Duration `env:"IDLE_TIMEOUT"`
+	Env                 constant.Environment
+	Host                string         `env:"HOST,default=localhost"`
+	Port                int            `env:"PORT,default=8081"`
+	IdleTimeout         time.Duration  `env:"IDLE_TIMEOUT"`
+	RateLimitCreate     RateLimit      `env:"RATE_LIMIT_CREATE,default=10/1m"`
+	RateLimitEdit       RateLimit      `env:"RATE_LIMIT_EDIT,default=30/1m"`
+	RateLimitVote       RateLimit      `env:"RATE_LIMIT_VOTE,default=60/1m"`
+	RateLimitRead       RateLimit      `env:"RATE_LIMIT_READ,default=300/1m"`
+	RateLimitPersist    bool           `env:"RATE_LIMIT_PERSIST,default=false"`
+	TrustedProxies      TrustedProxies `env:"TRUSTED_PROXIES"`
+	AttachmentDir       string         `env:"ATTACHMENT_DIR,default=./uploads"`
+	AttachmentMaxSize   int64          `env:"ATTACHMENT_MAX_SIZE,default=5242880"`
+	AttachmentOrphanTTL time.Duration  `env:"ATTACHMENT_ORPHAN_TTL,default=24h"`
+	StatsCacheTTL       time.Duration  `env:"STATS_CACHE_TTL,default=5m"`
 }
 
 func newApiConfig(ctx context.Context, env constant.Environment) (*ApiConfig, error) {
@@ -34,6 +44,40 @@ func newApiConfig(ctx context.Context, env constant.Environment) (*ApiConfig, er
 		c.IdleTimeout,
 		"expiration period for access token, use \"10m\", \"3s\" etc [IDLE_TIMEOUT]",
 	)
+	flag.Var(&c.RateLimitCreate, "rate-limit-create", "budget for creating comments and reports, use \"10/1m\" etc [RATE_LIMIT_CREATE]")
+	flag.Var(&c.RateLimitEdit, "rate-limit-edit", "budget for editing and deleting comments [RATE_LIMIT_EDIT]")
+	flag.Var(&c.RateLimitVote, "rate-limit-vote", "budget for voting [RATE_LIMIT_VOTE]")
+	flag.Var(&c.RateLimitRead, "rate-limit-read", "budget for reading comments [RATE_LIMIT_READ]")
+	flag.BoolVar(
+		&c.RateLimitPersist,
+		"rate-limit-persist",
+		c.RateLimitPersist,
+		"keep rate limit buckets in the database between restarts [RATE_LIMIT_PERSIST]",
+	)
+	flag.Var(
+		&c.TrustedProxies,
+		"trusted-proxies",
+		"CIDR ranges of reverse proxies allowed to set X-Forwarded-For, use \"10.0.0.0/8,127.0.0.1/32\" etc [TRUSTED_PROXIES]",
+	)
+	flag.StringVar(&c.AttachmentDir, "attachment-dir", c.AttachmentDir, "directory for uploaded attachments [ATTACHMENT_DIR]")
+	flag.Int64Var(
+		&c.AttachmentMaxSize,
+		"attachment-max-size",
+		c.AttachmentMaxSize,
+		"largest accepted attachment in bytes [ATTACHMENT_MAX_SIZE]",
+	)
+	flag.DurationVar(
+		&c.AttachmentOrphanTTL,
+		"attachment-orphan-ttl",
+		c.AttachmentOrphanTTL,
+		"how long an upload may stay without comment before it is deleted, use \"24h\" etc [ATTACHMENT_ORPHAN_TTL]",
+	)
+	flag.DurationVar(
+		&c.StatsCacheTTL,
+		"stats-cache-ttl",
+		c.StatsCacheTTL,
+		"how long computed stats are served from memory, 0 disables the cache, use \"5m\" etc [STATS_CACHE_TTL]",
+	)
 
 	return c, nil
 }
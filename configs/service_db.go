@@ -3,12 +3,22 @@ package configs
 import (
 	"context"
 	"flag"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
 )
 
 type DBConfig struct {
-	DBFile string `env:"DB_FILE"`
+	DBFile           string           `env:"DB_FILE"`
+	ReportThreshold  int              `env:"REPORT_THRESHOLD,default=3"`
+	SpamThreshold    float64          `env:"SPAM_THRESHOLD,default=0.9"`
+	CollapseScore    int              `env:"COLLAPSE_SCORE,default=-5"`
+	KarmaVoterCap    int              `env:"KARMA_VOTER_CAP,default=5"`
+	KarmaHalfLife    time.Duration    `env:"KARMA_HALF_LIFE,default=2160h"`
+	DownvoteKarma    int              `env:"DOWNVOTE_KARMA,default=0"`
+	VoteRingVotes    int              `env:"VOTE_RING_VOTES,default=3"`
+	VoteRingWindow   time.Duration    `env:"VOTE_RING_WINDOW,default=720h"`
+	MarkdownFeatures MarkdownFeatures `env:"MARKDOWN_FEATURES,default=emphasis,links,code,quotes,lists"`
 }
 
 func newDBConfig(ctx context.Context) (*DBConfig, error) {
@@ -19,6 +29,59 @@ func newDBConfig(ctx context.Context) (*DBConfig, error) {
 	}
 
 	flag.StringVar(&c.DBFile, "db-file", c.DBFile, "database db-file [DB_FILE]")
+	flag.IntVar(
+		&c.ReportThreshold,
+		"report-threshold",
+		c.ReportThreshold,
+		"number of pending reports after which a comment is hidden, 0 disables [REPORT_THRESHOLD]",
+	)
+	flag.Float64Var(
+		&c.SpamThreshold,
+		"spam-threshold",
+		c.SpamThreshold,
+		"spam score between 0 and 1 from which a new comment is quarantined, 0 disables [SPAM_THRESHOLD]",
+	)
+	flag.IntVar(
+		&c.CollapseScore,
+		"collapse-score",
+		c.CollapseScore,
+		"net score below which comments are collapsed unless the viewer set their own [COLLAPSE_SCORE]",
+	)
+	flag.IntVar(
+		&c.KarmaVoterCap,
+		"karma-voter-cap",
+		c.KarmaVoterCap,
+		"most karma a single voter can give or take from another user, 0 disables the cap [KARMA_VOTER_CAP]",
+	)
+	flag.DurationVar(
+		&c.KarmaHalfLife,
+		"karma-half-life",
+		c.KarmaHalfLife,
+		"time in which karma halves, 0 disables decay, use \"2160h\" etc [KARMA_HALF_LIFE]",
+	)
+	flag.IntVar(
+		&c.DownvoteKarma,
+		"downvote-karma",
+		c.DownvoteKarma,
+		"karma a user needs to downvote [DOWNVOTE_KARMA]",
+	)
+	flag.IntVar(
+		&c.VoteRingVotes,
+		"vote-ring-votes",
+		c.VoteRingVotes,
+		"upvotes two users must give each other within VOTE_RING_WINDOW to be flagged as a vote ring [VOTE_RING_VOTES]",
+	)
+	flag.DurationVar(
+		&c.VoteRingWindow,
+		"vote-ring-window",
+		c.VoteRingWindow,
+		"time in which votes are analyzed for vote rings, use \"720h\" etc [VOTE_RING_WINDOW]",
+	)
+	flag.Var(
+		&c.MarkdownFeatures,
+		"markdown-features",
+		"enabled markdown syntax out of emphasis, links, code, quotes and lists, use \"emphasis,links\" etc [MARKDOWN_FEATURES]",
+	)
 
 	return c, nil
 }
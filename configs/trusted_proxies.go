@@ -0,0 +1,49 @@
+package configs
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// TrustedProxies is a comma separated list of CIDR ranges, e.g. "10.0.0.0/8,127.0.0.1/32".
+// Only requests coming from these ranges may set the client address through X-Forwarded-For.
+type TrustedProxies []*net.IPNet
+
+func (p *TrustedProxies) EnvDecode(val string) error {
+	return p.Set(val)
+}
+
+func (p *TrustedProxies) Set(val string) error {
+	proxies := make(TrustedProxies, 0)
+
+	for _, s := range strings.Split(val, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			return fmt.Errorf("trusted proxy %q is not a valid CIDR", s)
+		}
+		proxies = append(proxies, ipNet)
+	}
+
+	*p = proxies
+
+	return nil
+}
+
+func (p *TrustedProxies) String() string {
+	if p == nil {
+		return ""
+	}
+
+	strs := make([]string, len(*p))
+	for i, ipNet := range *p {
+		strs[i] = ipNet.String()
+	}
+
+	return strings.Join(strs, ",")
+}
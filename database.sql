@@ -1,23 +1,204 @@
 CREATE TABLE IF NOT EXISTS user_ (
     username TEXT PRIMARY KEY,
-    avatar_url TEXT NOT NULL,
+    display_name TEXT,
+    bio TEXT,
+    -- NOTE: legacy base64 data uri, moved into avatar table on start
+    avatar_url TEXT,
+    role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator', 'admin')),
+    ban_status TEXT CHECK (ban_status IN ('suspended', 'shadow_banned', 'banned')),
+    ban_until TIMESTAMP,
+    ban_reason TEXT,
+    -- NOTE: net score below which comments are collapsed for the user, COLLAPSE_SCORE when null
+    collapse_score INTEGER,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE TABLE IF NOT EXISTS avatar (
+    username TEXT PRIMARY KEY,
+    content_type TEXT NOT NULL,
+    data BLOB NOT NULL,
+    etag TEXT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE
+);
+
+-- NOTE: bans expire on their own, only the ones which are still in force are here
+CREATE VIEW IF NOT EXISTS active_ban AS
+SELECT
+    u.username,
+    u.ban_status AS status,
+    u.ban_until AS until,
+    u.ban_reason AS reason
+FROM user_ u
+WHERE u.ban_status IS NOT NULL AND (u.ban_until IS NULL OR u.ban_until > CURRENT_TIMESTAMP);
+
 CREATE TABLE IF NOT EXISTS comment (
     id INTEGER PRIMARY KEY,
     author TEXT NOT NULL,
     content TEXT NOT NULL,
     parent_id INTEGER,
     addressee TEXT,
+    status TEXT NOT NULL DEFAULT 'visible' CHECK (status IN ('visible', 'hidden', 'removed', 'scheduled')),
+    spam_score REAL,
+    quoted_comment_id INTEGER,
+    -- NOTE: thread states, only set on top-level comments
+    pinned BOOLEAN NOT NULL DEFAULT 0,
+    featured BOOLEAN NOT NULL DEFAULT 0,
+    locked BOOLEAN NOT NULL DEFAULT 0,
+    accepted_reply_id INTEGER,
+    -- NOTE: scheduled comments are published at publish_at by a background job
+    publish_at TIMESTAMP,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (author) REFERENCES user_ (username) ON DELETE CASCADE,
     FOREIGN KEY (parent_id) REFERENCES comment (id) ON DELETE CASCADE,
-    FOREIGN KEY (addressee) REFERENCES user_ (username) ON DELETE SET NULL
+    FOREIGN KEY (addressee) REFERENCES user_ (username) ON DELETE SET NULL,
+    FOREIGN KEY (quoted_comment_id) REFERENCES comment (id) ON DELETE SET NULL,
+    FOREIGN KEY (accepted_reply_id) REFERENCES comment (id) ON DELETE SET NULL
+);
+
+-- NOTE: comments of the same thread a comment quotes or refers to with ">>123"
+CREATE TABLE IF NOT EXISTS comment_reference (
+    comment_id INTEGER NOT NULL,
+    referenced_id INTEGER NOT NULL,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    FOREIGN KEY (referenced_id) REFERENCES comment (id) ON DELETE CASCADE,
+    PRIMARY KEY (comment_id, referenced_id)
+);
+
+CREATE INDEX IF NOT EXISTS comment_reference_referenced_id_idx ON comment_reference (referenced_id);
+
+-- NOTE: every edit of a comment with the content it left behind
+CREATE TABLE IF NOT EXISTS comment_revision (
+    id INTEGER PRIMARY KEY,
+    comment_id INTEGER NOT NULL,
+    editor TEXT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    FOREIGN KEY (editor) REFERENCES user_ (username) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS comment_revision_comment_id_idx ON comment_revision (comment_id);
+
+-- NOTE: a poll belongs to a top-level comment, voting stops at closes_at when it is set
+CREATE TABLE IF NOT EXISTS poll (
+    comment_id INTEGER PRIMARY KEY,
+    question TEXT NOT NULL,
+    multiple BOOLEAN NOT NULL DEFAULT 0,
+    closes_at TIMESTAMP,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS poll_option (
+    id INTEGER PRIMARY KEY,
+    comment_id INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    FOREIGN KEY (comment_id) REFERENCES poll (comment_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS poll_option_comment_id_idx ON poll_option (comment_id);
+
+-- NOTE: one ballot per user and poll, it is replaced when the user votes again
+CREATE TABLE IF NOT EXISTS poll_vote (
+    id INTEGER PRIMARY KEY,
+    author TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (author) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES poll (comment_id) ON DELETE CASCADE,
+    UNIQUE(author, comment_id)
+);
+
+CREATE TABLE IF NOT EXISTS poll_vote_option (
+    vote_id INTEGER NOT NULL,
+    option_id INTEGER NOT NULL,
+    FOREIGN KEY (vote_id) REFERENCES poll_vote (id) ON DELETE CASCADE,
+    FOREIGN KEY (option_id) REFERENCES poll_option (id) ON DELETE CASCADE,
+    PRIMARY KEY (vote_id, option_id)
+);
+
+CREATE INDEX IF NOT EXISTS poll_vote_option_option_id_idx ON poll_vote_option (option_id);
+
+-- NOTE: threads a user follows by their top-level comment, authors are subscribed to threads they post in
+CREATE TABLE IF NOT EXISTS subscription (
+    username TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    PRIMARY KEY (username, comment_id)
+);
+
+CREATE INDEX IF NOT EXISTS subscription_comment_id_idx ON subscription (comment_id);
+
+-- NOTE: unfinished comments, thread_key is chosen by the client, e.g. "new" or "reply-5"
+CREATE TABLE IF NOT EXISTS draft (
+    username TEXT NOT NULL,
+    thread_key TEXT NOT NULL,
+    content TEXT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE,
+    PRIMARY KEY (username, thread_key)
+);
+
+-- NOTE: when a user last saw a thread, comments created later are new to them
+CREATE TABLE IF NOT EXISTS thread_visit (
+    username TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    PRIMARY KEY (username, comment_id)
+);
+
+-- NOTE: comments a user saved to read later
+CREATE TABLE IF NOT EXISTS bookmark (
+    username TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    PRIMARY KEY (username, comment_id)
+);
+
+-- NOTE: inbox of a user, comment_id is the comment the notification is about
+CREATE TABLE IF NOT EXISTS notification (
+    id INTEGER PRIMARY KEY,
+    recipient TEXT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('reply')),
+    comment_id INTEGER NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (recipient) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    UNIQUE(recipient, kind, comment_id)
+);
+
+CREATE INDEX IF NOT EXISTS notification_recipient_idx ON notification (recipient, read_at);
+
+-- NOTE: attachments are uploaded first and linked to a comment when it is created,
+-- ones without comment are collected once they are older than ATTACHMENT_ORPHAN_TTL
+CREATE TABLE IF NOT EXISTS attachment (
+    id INTEGER PRIMARY KEY,
+    owner TEXT NOT NULL,
+    comment_id INTEGER,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    thumbnail_key TEXT UNIQUE,
+    width INTEGER,
+    height INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (owner) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE SET NULL
 );
 
+CREATE INDEX IF NOT EXISTS attachment_comment_id_idx ON attachment (comment_id);
+
 CREATE TABLE IF NOT EXISTS like_ (
     id INTEGER PRIMARY KEY,
     author TEXT NOT NULL,
@@ -29,12 +210,154 @@ CREATE TABLE IF NOT EXISTS like_ (
     UNIQUE(author, comment_id)
 );
 
-INSERT INTO user_ (created_at, updated_at, username, avatar_url)
+-- NOTE: karma is stored as of updated_at, it decays from there when read
+CREATE TABLE IF NOT EXISTS karma (
+    username TEXT PRIMARY KEY,
+    points REAL NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE
+);
+
+-- NOTE: net rate a voter gave to comments of a recipient, its share of the karma is capped
+CREATE TABLE IF NOT EXISTS karma_vote (
+    voter TEXT NOT NULL,
+    recipient TEXT NOT NULL,
+    net INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (voter) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (recipient) REFERENCES user_ (username) ON DELETE CASCADE,
+    PRIMARY KEY (voter, recipient)
+);
+
+CREATE TABLE IF NOT EXISTS report (
+    id INTEGER PRIMARY KEY,
+    author TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'upheld', 'rejected', 'dismissed')),
+    resolved_by TEXT,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (author) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES user_ (username) ON DELETE SET NULL,
+    UNIQUE(author, comment_id)
+);
+
+-- NOTE: accounts which upvote comments of each other, key is the sorted usernames of the members
+CREATE TABLE IF NOT EXISTS vote_ring (
+    id INTEGER PRIMARY KEY,
+    key TEXT NOT NULL UNIQUE,
+    votes INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'nullified', 'dismissed')),
+    detected_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    resolved_by TEXT,
+    resolved_at TIMESTAMP,
+    FOREIGN KEY (resolved_by) REFERENCES user_ (username) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS vote_ring_member (
+    ring_id INTEGER NOT NULL,
+    username TEXT NOT NULL,
+    FOREIGN KEY (ring_id) REFERENCES vote_ring (id) ON DELETE CASCADE,
+    FOREIGN KEY (username) REFERENCES user_ (username) ON DELETE CASCADE,
+    PRIMARY KEY (ring_id, username)
+);
+
+-- NOTE: the reciprocal pairs which made up the ring, voter_a sorts before voter_b, only their votes are nullified
+CREATE TABLE IF NOT EXISTS vote_ring_pair (
+    ring_id INTEGER NOT NULL,
+    voter_a TEXT NOT NULL,
+    voter_b TEXT NOT NULL,
+    FOREIGN KEY (ring_id) REFERENCES vote_ring (id) ON DELETE CASCADE,
+    FOREIGN KEY (voter_a) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (voter_b) REFERENCES user_ (username) ON DELETE CASCADE,
+    PRIMARY KEY (ring_id, voter_a, voter_b)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INTEGER PRIMARY KEY,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    reason TEXT,
+    request_id TEXT,
+    ip TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TABLE IF NOT EXISTS filter_rule (
+    id INTEGER PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('max_length', 'banned_words', 'link_limit')),
+    action TEXT NOT NULL CHECK (action IN ('reject', 'mask', 'moderate')),
+    value TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS spam_token (
+    token TEXT PRIMARY KEY,
+    spam_count INTEGER NOT NULL DEFAULT 0,
+    ham_count INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS spam_training (
+    comment_id INTEGER PRIMARY KEY,
+    label TEXT NOT NULL CHECK (label IN ('spam', 'ham')),
+    content TEXT NOT NULL,
+    trained_by TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS user_block (
+    id INTEGER PRIMARY KEY,
+    blocker TEXT NOT NULL,
+    blocked TEXT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('block', 'mute')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (blocker) REFERENCES user_ (username) ON DELETE CASCADE,
+    FOREIGN KEY (blocked) REFERENCES user_ (username) ON DELETE CASCADE,
+    CHECK (blocker != blocked),
+    UNIQUE(blocker, blocked, kind)
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_bucket (
+    key TEXT PRIMARY KEY,
+    tokens REAL NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    full_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO user_ (created_at, updated_at, username)
 VALUES
-    (datetime('now', '-1 year'), datetime('now', '-1 year'), 'amyrobson', 'data:image/webp;base64,UklGRmoaAABXRUJQVlA4TF4aAAAvP8APEE0wbNs2Eqym98m3/8B3Q0T0fwLSmkxq8zWrP9UZIoTzFJETJ1JdAeybBaNIkhTlMbzWv8zDhbvRwTaybSWLUwD910VI6O4SMGwbSVEU3TP0XyfzqP8BRPUZveJyACDfoPtzm+OS/3L46NeZr4wfd78re15QnBgTJ07i7HsmpYc9XLH6vWO8Vq6caY/6iBrn7E3WJr1CORxfX+uX70ygnw9zAPLe1vbwL2z9Sf1rYCBo2zYxf9rbfggRMQF9ZYqTDKUjy9q2n5Mkfd9Pf4cyMpKVKrdtrMbGbs5hdjZWfQjjWdq2Wa52Ma1wxF+///+HdmfVEfiRbNu1K9u2Sm2t9zEmJoAF7OA8pM0SsMUt7eTuXGx1a5xzTldgg0yMMXpv1ZNt267tSpI05lx7X9wLzoRzLpKSpEgBJCcVkbr9CgjL8pTkOedMFXgPuPfsvaYUbNuWrTm/WcP5D5K7O2lu1Zd8Q9tcExDd4uQnMfdKszRIDomDyAcHc99+RW4bKc0O7J4Y3kA7tm3VtjLGnMv23c9dsCRIgLwJgk93dzm215qSBgU3khxJkplH1epHb/eHV9+NqAqH2DaSI0mqnjf5Z+u6KwIgAHBQPrWcI/dud3tqVaXH3rqw8boKFEoFEYc+xT13/oM/fPTzL+XsL/P2+tq4LG/Fg6VimXOqeQwgBHBT+aWoncqcXh3z8PPlsluDoEoAPl2ZBnMpbef9RvfDP7Pnv9kp6LIdTTsadBStxEIzu1Dn0Z1YjwGmb1aLhbittlpOyQ3f/dc/VYGeS2YF97WgDivROVi31Ze3fvlmWbq/NzdjMB/jvmgLO3nYOm7GLaiG2rTSm1w1D+Fog+t9gC83ITu3/y/x2PrwKtTpEgfVNOUtwYgiDHiEo8JprDZ7bKu+4jdXK7QaZWI8Nl2vbbSt3d9ae713OsTFHFucIIlCgZqbfo6h4s0YuNHMQvDLbV/8bta9yXrD550hb/SPIhxebta2g9zfolER7y3cYRX2jPpsNIyE6GkjhxK6IbSZLAmOkhmjVozCOjCKxawS1r96f/ybvA5uyHqXS8be6x450WCuL4qCQnxFmE2mpGUmZCqlqnBoBnm+3dt45518velzsZ/FS1vKhQ7Zj8Fn8GOrVZx7OAwCY8FSrMKVSYTLUI5057nxvRfSb8BG47Jz/+HdAOmYaCC1yCqACO55sUEKWGxgKuKQBdku3f3T6jt/8/zpiVYuNLZtlaTLSmwPWXYHeJxEebhOu7yLf8LWtMEeOVslTS/m2EZ96ys6VfntTvqB7YXlnw3/epxnzT5kp63GW7Xrp4LTAwEL1TuxQxiOwGjHj82dd67E1ZV851rZ3nZG07g3GtMoopV2V0YxpV+HhdqurMN2TZHaxhFFHPBkqsw21Jfi1KEELILOnYiehQfLrD7Y+v3rL3b/oXh04So72BHJsqjczDbtnd9YvMJMPeGDKKSYcqTHfr9T1rYeMazp+T2kzkyc8FZog252yC1gW8+Bz1ia9/KE55daPhSXq9P1f+R5UnMziaiifCnDKNuB/kCxGK/ub61PbwH8e3P7/vLB1q/kZ14T+wjXY6w+CuZJMkJllC79DZ32f9wTHuqPLe0Xu3kVL1dr+n/rW+kyyRyUprQ9ZNs07mc5AUhF4DN6MfmS6X2rtw3mJl/e8IalL5uJLeOQRDT2IAcyyqUzrqfhUoil+sr7j/zglyfevPzr+Gp3f5yPjHgkynsNPzLwa9RBQ1gu+d6u7dNQjfimkQVa8U9n7VpefJW4FdCQ5dlYFyYkpmSA2WhI6XAwE+Go1BXLYxuMTQM2D8HW8rHpCD4O/eOQMGTANsvT+lk4RiP9ANffzD72+N+GYT295nDj8551SfsTvX7iVQRLNM/b7mMQnxy5tTP/w7mHN0JPK3k2kcPqCbunWOdiLIlm69SQVDFhKLMWY4gGGkUD5nK8W+wlBQ5bWJtx6Aq3DsN940R/hJnhRtlYJTWoyky9aQSIvrF94/j+zq++fnC26Ow43z5JHrKgt5YXe1GPCdlyXrXXM3+5GNUW3sfcucCDAXGOxVnLLhc6l1M5dOeAQgdBD2iDEF4Dq+LZ/CgDbXVM9g0CPMKdBrVFXuRoZF7JbWv0oedlDWs5tppkFGcMUb77PcH1AMDkG1nv6l3GU+OHAzLLbLvzFv/O38fHOO2BR5qd0gMqy3uRyj5hl+y0XX3AdBe8F1mx06eLefIfzqxtYGzFUhAmwBnQZqMJFYpxnzk1eDKaSGPzqthmKheqRdjoXhzfiy739Fys2l4vKq0X1vRj74uvEwFnshbNXQ+w+ga7fTDvRop1CkDRcTzeu7g/ZPO5WeGtFadWudDFaWHTliz9ERt9a0Uz2zCo6d862/AJn+AuXW6KgI2GgLrYgEkDwJCAwfWUR+gKWsXpCGoqYyFhkG1+HMTzG8XrJmOp9ot777+mls8EPlk+PsZiKgDO5Er+bzdA7HX69fV3L77zEXpBug/KB9pKEM/kPnvi+oVonHhH6Ydcv93SZZh9vBn5EYLofiWZ1Ha2MyWlUYcXgxzI1FQu1ACZUROajERpoRSMgRSMWhAXfA5WGOteXQ+FupjzUuFmiovl9kIjTxb6hyEX7X2Tr6Y8cCIYwcoNAMOvtb/cfz+xEvedBouW8M8Xnu/cdn6u8/pFrJ+JlM7bm8pmZDmpPOCF05lOGzFc4JGNNogAISZmsxbbCtBCip1QYSoPJDCFykJnCaDbYJYtKlgDuT19kJuby+0sdW+qTJa0j6k3Jh2FCNwIjHkpPPoOBQAfX6Mi5lVmIlZWbTERW6Ib0Z7K4kvmGPxtaT8sotp84n3/G3wNoygdyjYnsQaxQEeBQjRFNcl8EBKHwRxiWmQUBqjszpsizrjOHJpTim2NOyVIoCkzIWFuExejw9gROMMkCDAWLVjAGR0A7a/az/hRnKfbZdeb0kmmD1uKPwnbTTNec8enuGz7OqweJ3Mzpi23P7djbK2ArFZWsyWOwBUIAOGIpoxbI9pqc5DtBECUyqbNrQxgh3LnGi5TLURNiIIB4ncLejRHNDZzt0CLVRgXa2MR1nL+0KZ3npmv3kO161ct4NdJjs8sBXhuWF80y3vT+UitnrLaevFWfzzSdZEY2woeIpBmgERggIWwLGEbeLZkNClxEQuKFqwsou5krgIi9jiJqmZpAsCtqDi1ihAHmDTONtmHqR3+FugoylSYaFtpbFjKHDcBel9R2r/YIPdvmD4WdKnXFIwIPTgKV5AR3QY2p81CGwnn0BxmLZStpIkIYGNtm57RaYNUM0OIxbZSuqkm2dU8FoBHLYgOd1zorblzZgE7zCa5QysMCMKqq/kErTYabTCgLDaadrFAKfMApQAAfOfuT7XntpSEfMTDtWkVekjiKZB5gsD6tVyKxeNkbYqnYb6KP6SoAorCwQPpjnG18iR5NWbJkNJwBZwThKzrANrIvLW/pWMDuM2ZW4uC0NaAi4zrSbOVCTeFAi9Qi4HScKI4AJgVDFKCkVMHMA3g8DWnj9380/XtY2zeQAUC5ubJAplW28L2JgZIZVoqc1SIke1DWZB7QGE0TLINWPwcVBmbQzyDRmiEW46XcVGpZJYmk/qINlUIhG2HcPT+MBmkFeUQwMZCArUsTZtxDEeLy0pDEQpo7z6AGwAmRx0+3KcHTneauieIQ3GPF2zFKAdY2rmK53ZpTkRYpnIyhmiJYlohk6MlT1v3Ip4EA7qC9pLNls8JDuHrIdktl9mAqqNlyH8AKJ2ZI6Uf2ksi8fEVCZK2McTBVGxK4mU0FoPD0g20xDGUIKJscMwAVFj+FmmOJ2VWBGWgJoSUYxFEAkKpQ17/x9ONEBINHZpv+ZeRVRgA3gwizqV6tjFiI+oOGcPhW3OajDewFiQKBgo3YlDMkQRIMsGQh9xSFjqgSWhKPaZEoxd7FOce4nbjhbSejVbDJjzhY+vVsdHlz2lrdy/2mON1wCwqItwHDOMIkaBK4JZT3N6m3Dosh5ALjt/OQVWgF4OpGQvvDNkzZI/F21hQQ1taSBXSLd5h3fSM/BAAKsICjmAMKhehkxktbNA7m6liN7KnRWtLdj5q2Ndjuc3NEIBFITaCGJuyTv3/UOn/ygQDF26dQFkvzUUIMASlNBwbtJMuSZQdkFlshZXVe/PYGucpXYf9Q2bflPuuNj5LGVarjewtzSmtIIgyLM1ICIJpNm3EAQSk2mVTSM+9zFtt5B108V+I2tPmj+Yd2U6wxQqMAQpSGAXKVm1muDBxUYbn6UFtA+ySBxcQTAksjR5n9zjaiX+7RXMqZ9CKI9fbwvWW9UNjHjym+97Z2CLB28iuBna5qtY9b8jMfmf7eAqr+EvYAACiAjJm5DMMoayLWBQooE02oEm9veyiJ/jc3yd6TGTbr8hd0E3LtEy5H0NzAQkrKXkAQ3KiEIwEBDKMznD8QNJKOw5XNN1d0BMra/SRf1/OxOkw1w+H6p3WX8r6l5uU6bfbxccNm9UUR+pqeY8kLEgUggUAADAgBdC0yjV7DqKJnhfUKCFp0hGjfb0sRpkyHAuTADAFDLGzp+4KpZZW1silV5BpRRhDACDmyLpsa4HyAFW5x2FUjLE3bTCCqJgInhANl29u0imuTvYkyV5omFqKU22i2+q5wbr0FykRwFBGCiUtuZuXNhzQimhzMtlCNx+cILfmXD/pPNhkBpvEfAsixmGFgl5pHsQZKaWQjYRhANJmMqjMOGwD+Gpz2N19ujIxPkBTgGAFHkVNUWp0i0zWOOPDeXH8RRwLuIAa7FGMAhnlXtxbuHdhFfowQISBIaAjiAA319UzmUANJhUCsNpP3z/ygEMLXK0UiBGW+RFE2cAgjipcogkUooFFFBim7RknZcbDP2ohc1rJgdUCsqzQNHwVwoE78Nju/LDcfkH9IRbWqdosPcIqyhRTcvTu6rZvXzn3kDPkS7ECASpkSQ/rJqDoai4zRZQpSAKULYTrr84dxjGDSMqMUps0bLQK+7cSFIAKHYKAqQGiZCzbqRV/WkonopdDLlgFbScEGy0mJFGJO4NgC316opcTDOwoJvGHwt/+bOkuRph6Rx760A43m/xaC24NmzkqvA1G0otG+06a0IptMqh5prVC5MG2neZ/crbksZDmhCvQBIAimmXipS8mnGIIgqAMFWBQmmO5vRYXeGGJwxGsNzQAILh2teBpu+MdufXD0vq8PPth1FIkYoaRjmuvMLvUFxw9Y+5knMVtEdyDnlCwSS9Wm0QHsxXThj4cE+Y2nq3KSmzEBt2AQw7Rc6CWZJtIAFMIewNCX1BhaZYGAAUjMYRUHPN2l9sm1nVkFhWWV7MSBGjb6ajeWfiRlGehZaKDdYl2RIcpIW7RXmUmIu+HUd9KCQ1QCWCmFgDpBB1gSUpKYjHHMzBaAVuQA90+DgBIQBumBC2oMGHZHF+MvF44csExLE05DMiJPKEl9EDgeFuWW0P8CgP8CdAEiuLAhYtIrJwwcdI8D34UOIQeMQrRI9KKwakNcktDuOGkYINVAGaFpABqABDUYT3dEe1MesJ24YWUoZnFNzBgBEygAQ6srFuxXKpJNUcF3IyggY5NyGSgYdyluFTIDR0FiOZhDmZlGGX30iYv8AB7u73jEZVRYJR0WYbn8kwfznSbfHNDJcKIFEELsQAUpBVipCG4EMEB5KGSlYPBUp7lxGA7eNi7G0SIdUM1qnANQIJgmbCWiMwF3A1pSlJKGKW+WzhLPwxlu4pVgxo+MlpFa0NAJCMP473d9rzBO1N0uQCaPIQtFjQEYxHLTX61CUpgqxgsggawFVJhBQECAAhKoB8Qk5R8sIxqaKf78bC12asrx2IJY7EYtXB3W4rmEOEWIAChDg5KhVHQWahLr+AhHBFhLEoRpmSAIKYL13bj01obivuHSLNo2OzbLdgWnS1Z2mBtluCEDmRDyiGkhCCIlCQRQB8ElHTMPEAxLrVJ5KFVG7dTeDZsQQSioAJReFfMsdxjuYhxEgCwCoMUAtBkgkhCtjbGVXKMyUAim1ADJKiJYmvPGGLBfjNsQlWqKtOb+aMgknWJl41IdGAoGbxC7qZnaINGIQ0ANIgkLRBoGLiNQAuFRGk5TkM1KmEADAvAxPTKGEDoec1ygtGYGyWsACoQhkhQOCakknorQdrmgfJ+oCnptnycdj69ZqzLwKvCZG41UYIS17ASl/8/8qv5559xo0fcpqJ1U7WIcalEiTP14Kji7HX/Yrw7rqHFCoLGCGpDjMIMQAggw6OpbIyMJDkBaioWjtCKPKHTHs7h8myZMdPsUQ1gSS66Sr95Da8EWGTxh1AHgHSxfm57FT+d/QK/+8npBdwUI07QUEKKOaGgQAHVrhlsEKcxDyDgMECgbcoDAwSYYBQAACseG6CF3K2kxXlT5FLbBiUwlzkkr/LOlP9NTLbh9RtjUyuI94j/4rcfPfLeJmzRAWFkwkaUNUqH6fPwy389LjQtIyBpn8etZB7kdJsCEWDVDaPiRkt8cAoZXqHGCYhmAgZYQKYeAwAAMF2JMd/AqjGFGhpzynNKc1CzJCCfcaGaxG6XVdP+D9c5swQAqeHHzZ1fXyZujYPUNrBkgIQQxaAlrYfMOJ39B2TPHaOMSXpB8QwfALwYLrIP6+z28eej/k+WUABlaAFgxPyrX2FTK5pJiDeH5gsuMBJqodgC9qSwlVM08fO9ffXtAUI5ow5A4Y8L0mTyS6u9I2xB2FstDkGILdQxLUUp2iiQyRJCDEymWgCoZZrrjbu2q5nLq/aDOwXQBCJAEEQHUQCvwO5f3g/Vm1GaG1KGNQewDBAVkxYSxULFBrKFZDeEFFtCSoooSIoS5Q21YQftwrUpRApC8foJj4CmDUkUoKaAAoERF12zXV2vpY3v9ZKiAQAIVKPuTfeWzFcF8KURDNUU2MyoEQwRp0LJGFOhIDYre9dpeucNT4BsOVGGQACEGLyHDpSGCSEN2iK2hn5IzUJHUCqhWJIQohBwgACz1iq7n1Tu8w96WhMM4ACBbgy99htOfDfQD8Kc8DAmgAAhZQ50IhckwIpbLB/+oJuKAOtiVEYYLSHAa4KL0GyKKAnAirbTkiZ4g5AQNjXQBbBDo1MAxWyOWTeXy1lufb+PDjCAACKFjZe7AQCvZXep4xIiJZIYKg0daSu5JM2ys4QVlvfpno8wf4vN/0NjfDE0VG3GAjOIEaTUsokQiygVFETKyMlxy7vpk4JqAQAAVOZ20y6AAU0RCsEo4FAWRApZEQIRJMHVkfL60fHXGYDmI0H3mI29ad1ZrIwQSiLDvCpQshmQF/v/H8v5v8f+uoEWlKi5WAtlqLycwaXwQC1RVwTVuMEMhi11PMo6yJeLRPOwFgsAAG30umgMAADrhgriZsJYiIqyoqsFbcpNg0I0Hv8vwesF4EyQnhwjPxkTlRFbccjoguGO4d5Wu+2taHtI2gAY06Bk4CJszSErl8e8l5IjpuA7hIIqqKzuMr3Nh3os1mCbYIEBIDMadAxYC9AAoIR40woMCYQlDnf2tkSgQIv8a1fv0wG8EXaQDmMk7u/e8cIundkYMAtoQ6ZImzjn8Ff4TepGpOLNFlp8S/0o7pw6F9l7FhHCJURChAHRrFxmOIbEm2wtBgglrE6ImwEAQGaABKjBEosh960SYnPEsGZij3M6gDfmJz/8eOFO5FF+1diBia9aFuJeItilt6CIMsbGfZs6RbeXVrXZHvlAOKbisN6/D+EaTCYEBqjAxgSUR6qNKCNfntwXWw0klrAGEAA0kzgKTTM01mD+d3bb03Lyy8ausL2fCwG8GZ/80af75Mu45j6WcAvdphnag7U9O2NnO6tiE9XI2aoM9SzhOGihEYIZ4b2xBQh8i4NQAUiamXoesuzZfvANYlMEElTGciho3pSZvcQuwzfibtL83wWOyJt0/gj1pH4Ab8pH//CdrcXvfvA6HDrijWJiuI3DO7r85hC/wdL8gMpSoZGw3jwRgdgleK9M3hlByhQWxQlDSbacKG2DnoY9va69f0fzROxnpDa4MjUhCscMGKg9lWs3klCihyF8acHtYetG7/P127feXAD+8f2fbf3Fd7/ZTalrxGVF8cSM5y7N75t1I03yGMyQyDfKIPtGAq07HEmENgQqBMIgzEO5Kb17oR1cPrHIsVR3FJvJtOI64n4I2U5+fti7eUT89Grq0TaXQcdmWojH9N//nbe3AP4dQAD/AK67G40R9ZsiOlsnw00YFd0LYnWssJWzgIaYMvXuzeyttFbzwohAJDzBU5xEsIgoUSXb0+qf3Nz+zZvVrz4ML0VWM6PwXgbXrdraB0/b/vceOXOK6l7K8G8MWgE+ADgYAFHcdaALawOivEZSYhG5CBnBHGSEGtgfZSxrvmnVjtpqtTZVogSSIEQZRqYQ5SiN5rLccrfd9ZGcb1F5W6wfsAMYJV7Rft0muIG/fEsni8qsQeM4gAMDMEkH9f/pTPbyvaFDpHhM7CjJDjlM2fLRUG1ijYqloqipVSQhEspKBWBaQQxlSQ60xhMlJ52bV/JfteAwpybx/6ArXlwkCKPtpXadJtW1zwK4AQBWn/reL3ZvvkPDPit0ElENJ2Zxy+BAZ4jEyGLVDqfCdfgOr8KvcB1FAg0iiGrSwo7Hmn5oqzC4tYLD7NOJ3sToJHqxF28e6h5HeBu+/vmVEQA3BEAMYNjnvmCo6iikTTWlHOjUkBBUxq7bJGcc44ZriApOOIQMQQUlDMHG2ZwfSiN6h4UfDBt1UK9TbbO4hR1sTnkOXXmqEQA3DMBHgHbv6si8dFU3r66n+RgorAQixKuTcH+MFBAQDAE6KI0A7Sjm2cx4CpCi4E4sXmNzmYpjAWU+2E7RUv//AsBNAeALQC9A6fJHp7rFW/c1bsYMhmJFwZkPhPtTUEULGjEEGCXRHWV+f4GEmWrawiHWCmbjWKP0T7fHAD8A3DwApgFuAFQAj9KS4hSj2vh48Ng/nk3RGisabVj0WwoJfNnez18c1RQWj/PopdhjrjbX2IF7CAA='),
-    (datetime('now', '-2 years'), datetime('now', '-2 years'), 'juliusomo', 'data:image/webp;base64,UklGRsIYAABXRUJQVlA4TLYYAAAvP8APEE0waBvJkbKz923z/AE3DBH9T+JAk32G426VKctn/Am1Z7SzkyBNElmwiiTZSb9IFIB/hfdFBh2OG0lSpFhmZjgw4fw37LRPh2kkOVKdyT/GC8H/N0PR9D/AT/z/wB4SgHAABXIi8jPyde2z3a84nnrKrr5PHQVINgzlpq8QYTIrEXlJR59hlFXaccOXuvPzz0c7QuEF1BG7dhEZYCBo2zYxf9rbfggRMQHNCupHUEEHjezY1qZIkvR9/2/m7gEJzcykdQ/oPCPiTkZlkphBmw3UaLSDkZuZmYqTwt3N7P+aM7NW4EuSZNW2bdsy9yi1tcGLmVcaOEMrfSsRzGt98Rcz9TE6jFZLhPuRJMm1bdu2zD2y97EwopfsCmxq07smu2qY3PzmVzU2xhjjvUcfvYW7HGrblje5/pOuP6cWmDJKbcPdZ3d3d2dyd3fY3GHthmztlLTnpMEz1QWvkAnwp23b8rbZ9h3HSRfJIlMYyszMTH+2o+gkOotOoTczMzOWubZDchTrIl104jENTwIgOZIkyRYRsYiqmaPEYJW5ykvk5TILV52xdhiXgzKoKkLkW5IkS5Ik2yJmEXWPqup7//DtY/sDruFhKsIRgADgpM+8+ne01tsYKJVFk6xrEqWu0vFCWExYa9U8fxSuH42rJdSG6mT84d91nL4FNANc0f949c+B3XnN7+PJlKskAgkhARWq6YhpYVpRuMxaTYF+o1mRh8jmuTeN21aTPADUXim//vRfQ7z1aq8u/+Ov/s7qQAoRZyUJY5saG4/iqKusovgy6VX1Qi/MJfIG/cT9pInYUaX6Ru/lfHgL8OMK+OOH/1k472z91Y//sYyvVgigAkiWA5W8vY63GC96tEOu17GcWMV8rYX+C3oIXiEX9pPmgn6DXsFqSHVh1B72pbhw4bQEQeV12u9+/pd9fRkR2RqQAWBgz3jqsCf7OBxccz3WNZIl1s1OSx7sLWOetnjo+Tfof7gf1A/KEgDRgupSAai5mzPt1/8mOJW/fOx7sTxa0fkRpUoSDEKIHCqD1quD/+fmbI9+re3ruGEdC4+murZv9kt61rWxvLGPFK09/9v1+2/W+xIJBNJqrlpBASD5HpVmxfe/fIudQmzF0hDPbRnEMhlClPJcGHt4Xrx94uU7wx43fRjvvkNrue3Ni2tWPVqRe+1x7KaWK3ZU1vXUvFjf/ulrHUnBkeYVusihEghWd5JUTXyC2InFOFmhEISxfEAKAAVg9Dh32vuRm4O2bX2RRR2X98GgO2MXe3zX2ZsUbrTXcKmrL5f1WovT3rnZAzseBLtaVStYVMWCKNWhyAxmKBwheTJnIPLFz/zsrIXjnkZSAIYFABGLOwSCehlbC2FGYKWZmz3eZHH1ovdXAQiyh+obMBClyePd1PVX74eVLSmiEEYIIKABIFAWUFBOdrzy7r1g+sOXbwLyxzsDkac/++sXHi01YAERBEBQBgAAgE0uDq1zVpth9XXPd/Z84wftSrIRGDeTiLTF0eBmM3X24vXS2H5hCUUMogIUoCB5RoSA1kCsMVo069z6zpDF3VcPHgvQ9/Snf3eWsJQUMARAIAOGEgCCkTSokh3mI1945pu9b4Ev6xqzXTIKVjTJokhERDYJZgiH2C/mjvU2eEseQbxEcCAOaYIk1AhEGCUaj3gj340D+o4z47TKxwFAQAibMHhIg2MBEMSoq3EvsSNPP/NUHUU1SyRmMsItjGNnQyRAlYVAEhKHNOSR219v8+8Di3cB7YHDuCBZyGhEBKmIyYJkFuaZPobcDKBbUSSABGkoAgkxAg8ARiZAi1TQ3nR8Pc/eUBmR7SR2uoeb2FEgTRLCmkKJEwVir4VObb/euP1OugIVIZbRsiOQMBYuyYAZEqkArdy9VjOfqW9yGHXQIkGEZJgwihoeUCCAWQS2aoDGxaOTURywxBEIh3mVnBMZBBETQ1EJKcZlEiYeYvj7ePydTVrNjGOAkUwVMVDGwQhltETQxiK2uG3HALnP8LNvfS1nJpuoBJoQAieMciqlnPIUAwCj6ENdnnUSVNsktIzEJiYpmBke4Qw0J9xRpKjAODIglrRtbP3d0b5pG/KwUVkWZdRBhJRJqALSQDTSGWGy/35H7lMA+Zy961VpRS5EoASJNCtROZEoD4ALGOAY7gCNINPQHFUaMZFRe3jZ0wRIYBVHFEggiZAYkIasc53+JvxPpDJPgBzsWfQwmU3JIEWZxRpW2oIqNZCXu4D8J/0CFf50fbHP+hCmiGxE6JKHpYi4LyBgbigTi0NKKLxX2t4zY2X04K2bSZkAuZS0ZGhqW1U7kdZuODlXSERZLk9OXszVto7T2/uYl4HwMIxapsUFTFnKYp6j6eXk9z7+38JPigdv1TOAAgXBp2hRBlQw4CJCAQkDYAGllkOhAkpSexbi/65Lu1g955/vjcdf5zrxmIs1vz48e+c1GycP/cDk9s0sUsJW4t2AY9kGbXUs5gogFCgRYC7mQgwZ5KhR9FI+2PoJr7z6SXjr0/eX+QkQYWNMvoHACBVA3BcAjoAKIspKraqz1sFUc8r/k4MspmR2Lfxa5495Qp3cdz20d3qi4dvgHdTS9WfyZ/W782WpWBYuD3uW98s4qDvaGFBLWrEW/ASJNNpIa5vjzRN/CT92uWf1NtEWIKQZCaOqxaqFBIAYABAYFuIQCSVuVd78rz/TLRl95azdjXnaW5ZtoeNu8PE+zd7zjD97PKWvddrKPsGsCdrvQd+UbCIgLxGb8tIMlZggQR4MkEWCGgShQ5EWF116dVZ/DJXlGrNBzjsG3pEwD5qwGTkywheBkBFBBko4wqK4/NGpTHHjpzP/hvrG+tTFSdsv7H6W2dQcvzyl/Glyin2AeW+bHfmLwfhxsn7vXvbi0/7gcZ1K+pAV0M3DUjgCRuSGLQxsCljYVBVlkpRiOQAAwE++8vWBv/zpRxwMvGPoOwOSyMAcihzZjohGRUQUpoUfaGd0+K3xc+mql3740F6XF5s9k8jDZ9VjZHW9jx7P/eSWtU9d0z+dq5qHvjoPdMXFlrk/uaMzfvSj+9cT83cMJsqWscwxKZSqljpmExFrsdqiLkwBKt54/cVAAIDpeubncw3IkBdAqqLFFCM3picMjhRjRngAgJcMPf2GX/3xutyzIL6+h7vNige9QqzxPF3laPKImoObsMeXTsv3z6O4t9HzcOpW6dxnPvLGCfON//uj+XMz4kcxEhmUyVU86gTGqqzIJtdsRFly9JP5AAA1noyyHvMIspoiXIYkR87wwzAvpoyZy8RhsSF0j373kMex53iLy1Ho2SQsR6/gjKYuL4pVN0cQnQa7zWqSzEgpEUSQsE4q2j6LRSIlm3nJA0kU4QzXQSP8IFWfI+nIHMvIVeBavbimXiYD/OAbX0R/+71rQJKZKig5CJLgHN3c+NxZm2kpdzUx+iHX/6r9yu88PZLfH1sjFY5wVTxXpKzSKhk0llagWmoy2eJeHgslJaHRSMVdl3rx3heTPfvW1/roT/+ThoWW8ikvo0avynVIgxf4PJkwxIxjAlOr6r3+SuP242djhnUxVaXKushFHxTYd9pLSMh4Do4gS7rzmHx8YP8+1b//rD6BSnEHrm+ywiihebRbcP97crG8ysfQee5or3Oluo0PfGpqBjL4IpO0tl2bb4neWw9NqlLBktRlKSAZwQoyvLBsuEYZaTxNS8G4Z/Q150slddCYJS0iH1mHGW3XhAUxDPqMPCGsAcg37+k+/pXewW9/6/QUQ21NpfGS8Iyi4xt5Xp+CsN+CcZrrWC/0FJwrSNuE0ugWmRB9zxtTuX5rOB1eamfRwqqmIQcDcrgPc6WIM4J3zb2o4gTjyl4n6bE0EaCeCN2ACokQKhLIEpNIwowcidFpfsjKP//39Uc+e2Dl9ZW+Eg22dqWbusOZDJ/6JJ/F4cwDxzKX/k/3Jgv1HPFpLXBULOXbPKff+f3bgf1IhUSO0dClPEgUIxQgESi32iwRC5KMyJNcthPyij0w0rAQmqGBZWUhl5kQQBFJGFFSVE154P/fntMdqhHMkJkMYldfrvrE5U1pXEKqTF3DXexJBmdUqqnihERSXlkt+sq+dpFMyOUKGlSlFG0xkoF71CCQ5ktJNIsGQBJJJFQqCRqwmApTAeiiBRKkQsIJ2DAQFVpxIGE2prw+YXVMmJCwSC5x97o6Iw9iOrsh6fpqTdvGn777+70Je7dPpO0ERocn/+n9i71a781xWdIYJJLLJBGg4UuMikhRCclZNZiPAynolPJyQktIwycMhA0JBBKaZEbQC2UMqAAFgZdESri2F133PKuXvPts5cdRocK5Or8acLKTglBOxiwOr9ZfOKYc32M75cs31eriH7/qS8kaxhiW9JiAiKkwJUteoNBSLjFqpoAIFKpeDesZwg2BYEMYAEFgTaJGDTSryMzIVKa8jCOMVoqwGMTwTl9yyE/fpeUN6Eb7hpYe/UV2Cfl9I+jmC9cdm9b6G/U5xGtdVNxruVWhxDWMy+XESSwElISELqOCgiwbbmBQlkCwWfOLNX1UoWppBDYFMYhBIEqpBKlQ0WJCsuQqT8AAY8CcChDUIjebjeqAa5DNEuSAZ7WXu8kP9NrTQyYtEU4c1x2CYYsYEnq6WtvFaJkFmcUgYcIojjiKBUGyCwiXIFFSOKR9AvUa7Zl+tDjkKjTqVIuIMJkEqsICwpFEhARGRrKFEgAglnKEWOVhHag2kE/m5pabjkOppLMf9bO/tNXKdEAaLpNOQFGNbqkgA5yNFFYBY4XIChgAJFKYAIu5IoCAQl1S7R8HL24/2FeIc0Gic4iISKgomMADQVRWKJDRY52tI8RAzAIyEhiEprSeSj0xHNgKmgbXO8iR19od5KusvGYqcAMdG95Hek3U0gOx3p62QYNApFFJaCUYX9KpLNVSuYQvZdIqSz/GZ22JUWnt0pRFEFMAFbRala/wAq8FLFMeo0QxQ5rNGhAMgSCobqRBXCkrgXEMyUEbAotFCEDrqfVzrnl3VJcrKUajBrJGdFSVrFNkecFwBTtKsDkT7gLlMpfEy5gilrQc1rOhrUnUCLpcRQQlpgqDrnRdLkuuNOICEsohAESgWrArEJdIRVbWI1egnoslLdv5QRrq4U0QdROJua3ljKuBQkpwCBEO5wZhuC3I44blM6YVMikSQYa4YTVyVXONL6V7rC5q2JcyrTbZqjSwZ5KolILMrpIpd+URnwoDKckUk00pRZpGLeQT+UsgfSOJ2lro4Ey+ycBWrYOVeV3ef7YO3NK17TY7LQcUTnNKKAywAAKygdNqG7rH9rLpCYYcLgxVLCuH4H0tb9Xfkjl3SV2bVHpW58oylvm17NnIGTnFGFREFBRGw4JSELEQMGXC23lz1ZdfTfU/YAmykWGHLSf9LjFhuW3rAM0aO42Qd29rY7SSA37xbg4jLTBIIimrlRVweqn35MoRvO/L3EcbsRWO5MAz9N51h0+/f6tnn4weTOC+McpUZljAASQCGDShYYqJCiQoCYhYyeXbq/+9/a+vfuTVdaNsIZwEipvlIVYfy/XtlfhmMOSltzNssWAoBa5iUA42YzhAwBipxFBsWCvAOKSnG877t9o7Rw1MllwdYif59eO+AzT7kMoSHpIsXWFAMpKQBHKQBy4ghw0sIJjcwRViMKv58/l6kJ0ccAiDsziSoYk6smmyKqvK1HE6skzolEaIqY2NOFExYBywwIIUJOAyEqKaDEQLjiz7ehrnUFXacFmepgEAhs25IZKwLMk1BqQHFjHgEUOIIGKBTZGL3AQTRN52BLojjVrjJA7HQRAqoDlrynpFs2KXGsWNNevusnv+qtGroxEhAHhCjgemogwBqMCEIHDCwD5xIPgNLADQVAMArN53UB/rRnIkyzKjQ5gCZgEQIJBYFwClgAIAgG0D0wlWahNBAEsAeTCUqZVo5ZsGRpe87bA6Ozh+1+y/HqzEwybYFVmkACINJgAkQYQEVQY6o4K9WaOgFsqGx/MAAAAQ2Kjeuhh4ioASADAYAAAQ4RQBMgAxiCWwZ3Nm7E/DzCOCAuOFl4aSVhBUhy3abdsdQA+6EAYMdHBc0Jx2LEZMQi4hTkgCigkKpGSxlFuyqlHTyIajndQD/gAAACpd2gmGNKQhGRhMAQEgIIUYR0zgsEr6oLelyXxy/sTl9E63gggKCEABMYr3Xa9PtYzulxR5I0xc5CMKNLWyLt13u5/hvrKLdzAhD+uCzRJMeQOHpMRCMqyayqb9sKn7PQAAAMDZ645nP9e2u2AyA7QxBcABAQAxQ0zAuHL29/j7EODx/e+49y/2xAaDUUBI8iWEJE21rujHHIzVdwOxUS8ryEDvJodzju6PIGyt3V7bS9oq2SxlEgM0UktirGRrA17wtm/7H08BAAAAPwDFiR5MlLsS4RQkABgzCphTJXEu8v7On2EaO92jYau67uQ7/vH66KmSVZGDMgDXpOaOrPC2i3/te6/z5hf7drP2Ho29NjMpv0f9CzmLzgmF1vxn+9xzkasN9WguCi5zJHd0c3S66ZFFaiFfAvg44EDS+0UpNBOjjQKATREDUicupV4P3sL2wCFd07pwwNGc/eBr3v1DDUdSRdJoPBIc8++fS+9aXEfN+53GW996rRwqizGfg18thGQdSixMLw+f/of/X/pm7P5P7vz7ZeuurAsliXJJGnib5XDRvH543P8EAIDgdVpYUVM4hUJL7Xec3fHmiXOpy46zKwBaKyV3271BY/gM/DPRT5/q29n6nFH/UrZWyr/9fP4XIWqLexv+t8R8e4SyLZPPzamD8lXtFaTitDZb59qXl0batfvu5ra/2A3/kfWdcAIG1zBgg7S9B+BTv8EVWF0rMDFuXGh7p+21kcus0mYRB8VRRgMpkDV0DkblHeq77Nceh+XJPs/mtwmPDRfsH2/zoVszd2We8v8WuO9mBbeM1kgplyhImdZ5cunWmmsxYXjLEKRtO7+zG38nVz9h65BVWV4j0w9f74+ynwbwL4i99jbxpx/X+V4HiUOsVGWZawyytaKGOOVIqTgoIz+li9/UyeUnyTPd567nCd/X+H8+/qfS4ATODe4nsv+wPgBHyhLlQC8FaZYdlUVtN+ikATJJTqRTlr+vG36J9FhLjZVu8gNWTdP2c7YCfNZHHok9xxaOttlCyqeWgWK5x4qwhIgInlp2LX7SHs0wW/U5rmd3FMytH+shn9n2pjky7MK3Jr8Z+mfwELBCErJIriPbu5w0aI1xTlJR8N/d3PKzy/A0spBTfQzt7padC/DZL67X5OUVe7bNskkoMRZu3LUMesF/NmMBdfRLf/YzoEoKxI54yTpK3WxeD161Fl/RF6Wi+jJPF3GEqKhKmn6PhuzEDAKhyMdW0F/txu/g6rcyGHNEKlCHB+sAjlvl8+NUybhccnFGs+2ObPXwv8CFgXZSK/c6BJBmRPKyhGa9CG2HT9n70v7ybsoymxKeMpEeAeLk5h2342kXvAwGLN2NW7+P2/9SRzYJI9GQop4HOPa3vv3Xgll9s69Rqo5U3VRPLAcCwpITdZWFZKJoDwECHZ5k1hRakUpFIc1mxRjKQAJRbVOTk6K52ttblrLePrJJR7Z+iOv/hM1RdQKSUPXNmy89f3/68QBTdvROwbue86+s8d5CPzCCggCAhF/UENUsRhSRVGB8bGKuJcqnOCVrhcKVVONqNG7pFFF+Pc3VzaZ2S2tr0gOr2JX+D2u2+JQHlvL0/Deff7gL0Hc8AABA3/TC6RS6s2TPA0hCKLTCJrjIEOGgWla2tQuMQBJwV8rYAddkJVmaOGAcqapk3LZ5wNGTHZ42njE2pli9RDki2Xgeg2IzVUw3ltcBnPTND2R24o4RQOiQTsWYIyiMqT3hogBVp9VF3JKRaoyIuRZcUFItQYngxnyzekN9L5ubjF1jS+sor+W6qu2s121bjKBNM9dcgJNf87/chLOzhgsuMCWNYhyCS8JkqSAIz6IqpXpFBhhooiYOfCiIqfZwmZE5UrfNPeaWUVKpEqTghrn0jrdufLjhOlsBTvMMn+e03u7Gfudk9UYl2cgOsAk0hJiq4KIWVcPYoJp1AIRs3CEqSUrftfeGjdYSt1V21C3TBVjoEZOtouZuuuefT+9kAU4XkP/ey38U7mm2XvLZPCdF44yQAGSkQ7Ksj1gQI3OtCx6BYA0BT7T30BNqyxwpPaKtRqbEBdc71Puk/Sxc27u89xLgCny7fxi+6y+tNrzc1AqrlTMS6IVOMKHK9qn3jI0gao2gUU7gLAzaRNloHfMzXFjyvlN/dvXoufXeWUAHwBX6cq6BzWd+504etPmFJXukWGNM2AYDyKp0kzLWMtpY29YasZUfmlR/6Nz2PBYDzgJ+AFzJVu0/dGZ28v5OfdBIzUy+jnFrarrzVne7m9Y4+YqtGowfzNH+Mp6196wTATsAtQAnDA=='),
-    (datetime('now', '-6 months'), datetime('now', '-6 months'), 'maxblagun', 'data:image/webp;base64,UklGRrYYAABXRUJQVlA4TKkYAAAvP8APEE0waNtIkiade9Pjj/g4RPQ/5wedq0vtVK0iYv2wBTTpokk6S5LOQkCSYoncRpKkSHnMjP6bdzI+CAzbto1E9G/v9p/vpviDQ/8DSGLAGwCEhNyAGLfu5EZ0JkAKAe9w/zt5ASQ5wtv6M4O2yL0j0eTkHkHNjh1y+pAnQH/UgxwATNe2rY0keV/P+4FkyRAciZFQlQzNzMzcve0e5lkyz3b2zMy8YuaZYs7MqoqEiMgIB9lhW/TB+56TnOlJsm3ZkiRJWnuf+/6nT0LElapa2bMRWNd61rM52xhsDtaqzaTkguj/d8/ZviVJsiRJsi0iFjOPzL7M/fY6PzD//ynzNu/zWtdwNxWWAwgAATcX/RZrqtvYyVZrtW3b7WTbtq01m7ZkUu0tdupX5LZtI8FA521/ARAC8KB+y9/+mkj9GkJ5MkFSuZsi/UngaiQdd6SpAdnOtB2iQW/fp7PvCGvo8lUCqAd4pH7PP/xW2Nx2BuxoaLPEVwilOGXfV+tNo9uSA9pCQbIkSu5I+mIJ4wZTpzpti/PtfUDlo2r567/5r8jV778sWX19L1j/OzLCCxQFJK7Wj1r89ZL+/FL/Dv5cB/0Ihfoin0pavccl30EPjXdt3df2XltoZ7e2uHjdvN6XAe2PoPSXf/UfMeX373VVWLPpxq59Pnb+tvTt0AO7q7XDfx3uf+ALFbF4j8XzS0a1qFzIFVOqjiRd66lXZ11+ju1PRR+rc0R2sTnRnJ7ev50EtDxkSSDk5+rHPN97KLGJmeVA8QR/W1ZLjfhEvWu91os39K8f24Z2pMcl9ZorTVVglznHVF+OzPFGXw5cw7m2rpeXP+H6x1KGmlJOvcKtv/pv901A8GHyy/zsmeq5Pw4DC6lUlVq7FQMhBa5iG5QX8II7HvaIurG+Mb2OtxyRr2oXIIWuOOC8lO+qv44TE299jkzljeUXXf40SB+mbiTYPuXx9ZoB+P7g+TgMLOB5HZIawUk5azaYZh048EajU2CWDrhQHzW+1th6gmWeavYoPTK9KOUKsOQSdI1S1f8V07/qPddL17KuzUOXXI4jRRqRWxcOJgDKHzQL52eBq72w4CvwdW5EDEcHrcePylLhWBvHURwrA9phpqqC96Enuudt4PX601FV6SkA78WXkCv4pzG+VdAa/Vrqv+KDisduwaDY68Vd40ZKkdAUv513OeD6A5X+BMI+RY7P1lzr+QpYdEAxpTGZ1bu87QbDgm+kWcXUMjUrtWelGQMpdM3p5dBEnKCoQWGKe0lVPEKuI/KI65J/eTS3x98fsZTLG6X2Nc4q9k+khIo48OcG+GzWV/oT9iB5VXluMuszjQ4RpxqUBvPP9dLnt/4+lGqS38njdiw/6Kk96ZAwQj2UBiZV67I00aiSVB00QqzAIblELEgj6mj/9KDnx18eQyU+bLUdRjXjlesx9mPbx7hKu/0egFfgSC3H2IBAgoIiUNpa9TfsN7H6o7hHuxallnzb3tUrWGszsIkTKuvYY9zkWDFmEkSEJTwhPKlZpbNUh19oC7M8/il6quPDJS8pNOtC6ZqxjSAkl/l03/wMWUCe9Qg0AqEEGZRSWupMUEaVVQSRwefYYdR+S3lMjtvcsaqB8yCqXaLUbiO0XMabGC2iuVRxRb1gp4SgkfiIqkU+1nL8eeK1CaQgGDSTak90GAvWgp8F98n3bwb28IJJaCm1kBIq1qinkuPRWjE6y2YcElr1kKqJFvOiV6GGFCxH5rAKOWHOdQCkRHd0gpiPjLB6q8aQgAIT+AJr1Y6ZVLb/5LOWUzsRASgOKU465g0HF+b1HnjPfCf07KzreUsJKKAGViBJNuR8rF++bTz/ih+/AaMiykQ3Y/GqRVeHdXheFnIRB6Z6W0jFrIkfKBXUrmJNy2TNJ9afa3BZqqUjVsRapoouIstK+U+jc1mbSyupMItAT3HcZTqBmOv5vuO3nvda7R3mfheStKRTBLLIUOND3rV1rEKHgCRIiNwxR3Qnkr6k55jbl2wuLOlIWI2rqLV6/SjqsUNde2j5MZZBvD7i85qsC7xUi122wE+yx9IpHmWvZV4eyW25A8vEgJsFqfEu8HZIevv95/0/7L+F3M0PwzybHagkTSmFQZAC4ql4jPIc2XmJFTrVsg5MmBXDHeZEXZFOqEFYIUHjqo/ywtgrBkEoCL9RgonRW1UdAqFHCGhBLjIqdqSDxbqc4PL2/cOXz5dP37VDXA6qqX3UUaqDA3+4fpt3V34GYn4Qtmc1Eo6AcgVrONZ2rViBXtNofdTpCEcd7csiL3tCRL5UIkKg4kJRa+wVqQRQlHMp11FeQXOxs/xDvi4+pbtCQ94qf8QMlyNnHWBMbyCqpoa91qgVPV4cBOsYOs84r9FTvHPmkPr4MwXQcgcTso5Ye3noXHoe1tHRmEZNhJIKRomvuY6iNetYJFxKISiKpSiQFLXqVcNVDUunTMBAI6vIXKEu/rxUOWzX0UccPi+Hv9f+N9mJihpEl96OG6r1Y5UqqkqXunqEy8quh/m8/vPpW9giRrLV8ewC7Lydn4XIH9g7X+m4lgf1OpjbgkfGmgsSR63FFbKhzssS0VBaL1t8EQ5XEpZSSeBdblVViJIEiqqpUDVHTEmbWuUJU5d3/Hwc/4r+rKuslaqNy9q+HyON2S+P5Am2Q2eRAMM2zIqmwOtj9uVDTZDgu+T59zWv/H16Adpv0SNLshvCRL9LZXkPI7HQJqaImxzKIS4aDBwXFdt+GWmRxJFGGIgQDVE5lXeoFBm1ViM436ZzWIH3bWj9/6EnXlReH/ZZyQ3JUcP/1dbb6HwSLVBWqFBZKsuMtFe1qnoBHlKDVIJgnp/YQ//OEsCxW6QXF0BrSo/wwLYuzo9we5gVwx8x3wBBKWJYibKaVNQFCt0yQKxoIYgVrlCxiPLACxDmH1FvlHnJH2CVebo9/qnyV7y0FQruIqkll9JdrMM8XUwWvIhSkt3SK8iVXg7cB/ZF6yAX3UOt14Jb+S3uwl/3SlI+osLrOTq3o/iAVtQ+joWBoqQGup5f2k2dur0s1jC7yAHJpxhBK2oVWruDm4+19ZB7RHmH1zg4itGMa9Hr2K3Wvgyej+X/+Bcl4QEpbAWA8jCWmRKDDMUipICMIKgq1Qd3EADQNKN5XIWASgBZMwPvB03szpoxJj6GWZzVvOl/zpfrWpoCIOuJpa3BnOrNitSSRmA1Oow+RCgWlu947ufa+HorGrZXWzE5lLDe+Elv/6z+fHnpj9Xci/NFV0mJBGXoAgBv4F7YQwfKC4KytCBBqDBn8WXIXaahGibF4wzARoAERlOCt2qNRh+zx636A8/+/vJELtfmUDW0hSxINkslrkzNDtqH1rVWR00/uvvS0rH80KVfMb6HqACImlLNJnbqsv8Wslcv2NLazG36K8whUgGQoRcw8u/y32A7TBYbYPGKMGM4j2ou3X3kBjbCgBFdERajATm/wd8Sf5ffknPQAj2Ucek+jiuj/0yULlNQXhQACCGSnjh3Xlbv2qB+87fa6g/HIPTXPtZmFDnElc/KplqRraGCMjItg2zpMCPOreiRCShQgVJqCYTcJd+DHyAqImVQQb0vvEN+jvAeEdE16BZyK3Usn2S9db4TZ+o9xJ+3gbRBjVAebGWN+yDK7oOtgAAAQBnijMa4ccNIOSagzhAhm0orvrxx6nKDIgiu5IxC6urQheAVtDwljN7X6q0vdW5QQABhLJuHaqkOtYfsRUgVkIWO2Br/geLbrXkoUK7K14ousCFFzZDf6z8zVwmZdaQub/E6Z3T8MIRLgJiZdZTLGgtT2MiMPR03BolrQgFNpVIqBb2JY2/jDVdlz6tm0AYG4nJ+ofU29YqiioF4LfbD109lg1JoYCLJwkJayhbCW/lGnKVn8UBq3HxU84bJj7WbGtZq6hERIbbGaubfsakXs96E0sQATXxYKqWyjcf8cnr0xdQvR+xCUEsf05en5/FSvs2uYQCtAUcVdAatreSiM58w/2mpF5k17DXmPz9O/+ViXw/XIFXMsXm99ecLBQmRj6Y2uh46Q0XJQBo8iKmaVYDQazPldtVTvitIUgoFsRQ1KVJbkBKQoCYHCw40XqkwrqpSPqpbFKhZvL7IrOHUiRnIpTtrtrDSUFOhxkwNXWP+x9in29JfjXd9qc+96EtPoqcqLlVNCREFn5H+/+3a7UsWZbnkjNa+pDOUg3ohCL3GwAeR5RUVhUGkYuCj6BVW8AIblDDXpKRNQg8cRbtCAypJuipVhgpqDQwRDPbQswStqGwjp7aIXKuwIBz48ngLMiNyWMGq9FXTQqerXstHkLSWc2Eq+Y5D/482F9ultihAymrVGfWshiOkYhZbKisSKguAFTLgICqiBEqCZJKQ10RKxmN+aZXyoDMvWeRRnSozBKhgeipBHaJBrhSflTxHZ/S2VXI52jcsHI+ex43Px+7zx40rthzM4io2VFd2ojGkCyCw+n689vXt9P1gqiEm8yiBkvw+wqxQigYoleU9QMHDZckgRkyIlmhxCQBtZDdEDoQegERSQrUZ3SBIAb1lFQ9OGelgQWOoOnZb6Usc+JOqu74IFp8O7KM8FLQGx5tLzD4+3H5s+ti6HMU6uCCzVFZdiq29i/wNN+bwP9+Oj4Lf+BAO6EJURAcPAIDYJQFYWsj4aW7+Ub7/Aq0oiQQDoBki24kijx/1DwQM0EADpBQiYAVjpYlVQxLS641bi4Wv/VLDDTuqHwPHQzVS0L8cftYML3NPMepYOT82KzZvy69igQalMeyQirjquam5n9F+G8014khAA1xd7AYRsYAelIO5ZXM78fbfpt5r91Y36ofKv5BDag5E0SNT2ssogEzSKh0oCoAABYrSKOWya1Q6sq2FO+YrApBRQPn9Mrp9JLVeu3wQl/b81ehyG3EbncPocrzeencQMhKlhl1FBo4y1ErpbEbv/y8rH9GbmPtF1441WMCSPSEGBSAoZfcPM1paS/rLHlH5TSgqkOCgPettbxweJJShFABgEAEBQVBNC2UfleG5JHv19goKQQKm7mFOROr6AXd+ydZLa31pHbfp+thfHwB5wwzUYECsnmq79CHt4cDlpaWJY7/Um4LjZ9TW3xYGWmNUtSogWkoIXVbfHKNJqBPpvCiBBC7E89OeKA1u1k/WF5GEYsBSRJwKEgGVyhR6r250MLGhG5co6FA9xTP9El/fTl8/pnI7dKypOer50HxMW55ofPNCU+irrm4kZ+w34iqpg6OwjyTxkUSpYRVX55IY+fx0VJpyVdTzg9HUFh9n1+U1rdXr8bbvl5W3LxOLn6QNzzSc0LxvBmMwRpIiEKkSuUGkNMtHC8KHc0uDftUrhZv5GNc42vHhwX9W/Y0u3zjeHgeCfEXOgkS+sG/sNT7q2oHrezWnHl+wUuhyI0xJqkGXHXuIqE7iv0cy0ZZm5uVN6J2JdL38B9/yi+xxnH2g8+Ol//7l0hzr14eZh5TvD7HvAMbCODWYaCSVR5NSQS0UI9t628I2+h+91SyiBgXvsTD1L1WvXuBynLzh7a9fFq10pNcFvnlVrNpKvX69Xf1WG+/jsDAVmUSV4btE8r5YakUABYCojdqqvkYSmIxL0ttSZ4mRkLO6iX7W0kR+jh2v/pMo592U7xoNRrP8YILYMnkEIQa6K3UcUP0VK2otsbqpgLQ1M9HyaCpAqIlL72P1n//H4Y+jcxk7B/Y4AEYcG2esv6HcX7pPY3Eq7Ui2ijdIL1bIQFueQzMUgFRIBJgPr6ESe/r4D8YPMDbXh0sIgmrRWDqwVFpxPcGpSZmSVgjeoFYaddRdMCBBNoBqkfoPsJ61n8NyUAsgpjrS4sRulaTASoTuv/6v//in/zf+sGKt+qxir/FWfJM5ddQfZ1tTfUsaZmt4B9dNq0AwN4AEGA6AwAUUR55y6ACXjzhc4R8TtB8iAKUuNavr2FcJLj/+KAXUd+ZU36xa5ejXMWJVgpNEyiT25b/1mPihptACACBBRXvAEewIpS4jRf33W7X+8LbXlUnDPHDBeaLd6IJ8wxBSa9xjfIbWYi2ZQ+ZwLEkowoCUSqRJHpd4tKTDGgaogbJQClGMXLBjZYq3TqoBAFqa4gkYSztZW8HNaNLogmPW83qsq9RAU9FFBW1NtVJFk0CWTAG0hRkizhqrPnbRZ0pDr73jgCClopbuZR3jp2P1VDgFBRsAMlWpAgDQCETqIXqMvdilRyogICgClSqFLSLXkURHh2IAgHzmPoyT4EgiHy1G58AxY2C9yEN4kAOWCBnRM6b90opqVaXByEQW7AH20lqvcgwEm1EeK+hwLjXKgrTXQNh6gCoA2ACQWW6WZmlgWIwsl1ZGm0WGRKqhUjFFUqkYAeaxn1WnenAfAABQSaL2BHGx9BbpBIqOGj0XjQUATVCAkaGmwT4QwF6W7EDKWBo5HeIjtDYGr1AxxY3QCAeSLsuoXFtBiCAAAICIoAwiiISimGpJKaESagBJIlAxVUa7kudjFuQKBbWAbgAAANlcn7IWwGDp2hqVcWW0PA8BxM0AR5AQJDfR9/G6VFkAKrKtCJoqTIjFxP+wPit1ShPk16EtpNauuNHD+aIDUCQBRNAAzSJkiGyQJZASBQ2AhCAQUREo3uBx1FCjvdXrALekw8tJaK4zbkbbrDWNy6kRCyClAgCA4MDA2olcChMAsGwFo5DiQgwsq/R6rtYbhWItr9UeBeuFVP8R+YBUMYMsmwKFKJkIrETKAEUqBRVTAiJgFkukDxAnKB2MSXNz6ZPbANqvm9PPFEbShnWt1jJHKdhIs8IcKlBToJCHBACYgIusRCBKsmoDgIWuDlqrXweVmFHPeHpi9ZQJtBENNNhhgBacS3FpS0aAA0gAC2ABRMSBc8SMM6p0xk6GVk8D3OHKep/8t+dTH1zDpfXjmHCEqAdKQrtYIg0A6AZCIjZISAKJKgCjBgASkPH6j9FWsasiSqt+LGWoAhE60AYAEYFVcvSkhACCwMijIDESABAjsU6rkDFEYOvz7+97AHf6WzA+mya+Ylzzt4LVU3jBACAJJFCDlgRhTPncjI4eARBQBAIAMJCABTYA6r1UQQUhaGaZCEBYgeUi5wVVMqApoCgwhYGnPBIJKR3ZhD9iQOmUc7cC3OV/QkjaLNzSuuE3trpUFSnKE2yQpCmAGBQCWDyj7QLyKDpIAIABoAYAt2qm0RiVkE4xEVIaZBq9wLAECMVAgAhMMUdIRSo1Esm1/ICMQMv/ov/+4W4AZqEH1xmww0eSAjFLBAoBAACAAI6SuYAFgAFZlge5AqUGVACgggASYMAwGzUqPRIUNUQ4GC0xtAQAIVLMisBTHoTIEwYoVTRc4LRSqMthHcC97PJnD5eNcQqbsFRkAQAAaKRTmjBWlQPARsiSKFgkANzy9jizVxlUXQmLjRaYBSpDQASCRIqUAw2oVQxMIipkEI0IqPmdkGkA9zb0pHqqJTQBI0moEYAIweEGVQ6JNCAJBAkEBAAAAAgAuDXJ9KpYVaCJKSlDKguBRAwQCIqIAxdFFQwVkEACS8EG7GI5wP107YlI1YIM2ADQLIDIYgMgjAAARMIUlwJ1u0TCLVQwyZJof0Z3SoAICDiQiAccBMGjkAtHjcBRsqEGjVBrsgDu6wcqm2v52gsAaookAKE8khEZAAAADGAgwOCONrFgAMCwxkEjA4JE8JYYQfCCE1wUKB34RNXiwBtkTAU02y/9l/8Luz+Af/M8E2o4wRZJAJJwChkBICKBJFFpwIFQAAC3SpTS+wAAOpI6orIWU1GIQkASRCoMXOAAIhV54KKY8lE0WsynO0r3L5Scakp/SwpCBEwB3BIjDAC0iw0AAAoA2BEKPeqkYyGhpggwYoAUgxAwy0kxMkEIZJBLAIWUpb9tsxzgQTV83gm2ACBQiCklQAAAgAGgBUaSkAQAdgHsW3HGoxNwEC0vOBBBjNygAi4ggQ12CFADBkHKoK6m0wAe3DE/fkj8QBIAIgYKAZALg1sASMKvaCnOgqWRDhjEwFuRioHL8qhuDEA9sBEEsWBJiiID5aJnHcDDuAs/KFwjtv7WPAiCYksmtIApRgCHhXPCDABiwxt+Fk9xyoGaYwJGoJDqRLYrAwBM6G69crf+0eP6APBwAoL7//4rRdNdBM1wAd2aCCtOdB0XCo8FSYsFJiIAghBSPqsURgVKMRgJuw4ZmIgWzYqc75x/vAB4aAEtgJ1+373M2k3AWgIMwmMlK86s9SbVfCCSoGhESSSACAIqWZMBB/lIKzzYjiaiNjb73BeOAVoAHoWAdsAxQCbHIrpx/MI/3lUfguBpoAEnGEUkCWRF4FR1VpnwLD1QKYQgbBWtHh/TOYBjgHaARyagErARkNO+XkmqZxLCDrj3Y1nh7VdKGvXwW8XfKjZG8TV4KlK4b5MdlE62j4wEbHzQXz8AAA=='),
-    (datetime('now', '-1 year'), datetime('now', '-1 year'), 'ramsesmiron', 'data:image/webp;base64,UklGRn4SAABXRUJQVlA4THISAAAvP8APEE0obNu2TULOQuE6ov9BYWjKD9JdZ6de684RErq8kc5Jr7rA8GnZFAslkSQ5XY8iev8iD30WwjSyrVZnUG9QlHD6LwkFPycVtRvVtl1l/ZzD/Ef2sRLrdPmV4SL9DwCt3/Q79cGJAxBgq7XakPnPanYC+8W+59/m31pD0vWugF+ZvhoZgxQMpNa6EBEBMthdDCj5RGgX7TT8LTFSGG64AggC3zMAGENB2zZMzR/2LoSImIAsYchPUJCtH9u2FknStm2tyxxuZmZmZtRvqbRs3y0xM999uKVbZuY7McBsC+ZB2YJtniPbVm3bti1PtY0+Fy8paPO0HV7BTRIsgTcpwKQF7z2gZd+RbKu2bduWR6lt4FyoAi9ZltykxLKuwvqb1HutSQ60/YujPKed322JQ3CnTkerlbu7Q+kOEdwpU2JtOqRMZTOCb8dmJpnZ7iwTQLu2bdWNtM+58PiJzVDN/FUhdAKdQQfZAdToL2ZmMqOkJ3pw7z1pyJ4j21Zt27atlHOptfU2cLH+Ei0F1toQXSHG3mqrJfsWIEmSJEmSABFJVOOO/P/PvNPMlAgjoKO3/fVf/Dc4OyWn/9+L89T85UikZ5Rbn9U3JNcr9haTBrtq08tdvfjkU/k4XyACT/WXfvp9NcdWbmvTvSTndIA6csAABl0UgIDEoM0qcZ4818lB4Wn53jfru//p14Ps2pssAhjAbnQBOSmCYdCBKEgZOVE8weBU1Lvnbq8gfgq+87MG/3++n57268nUEL0AdttyHQkM2ImRZpExLruw0UEX7+QKUAgHSKGai+fR8z78mr2r0HH2/HXjxbuvd9kd0AGaRmOHsCw7FvObjj+N2fGvHq+Oz4+pWXpR3EFJEQgQINGxorN/vG985pt/dNzJN77/Nm1+eu3DAsBuRaY4K//usd8n98tv9/hLnI8/uzzeLHsgnuMTj9tn8dJimTu8k3NY8EBYtnQIEILUvm/88m3aHZx/ZFpsngDFJCbzt/GT8s+Fczl/mfMx7izX8ayXPOv5OO8xn2PcOftwnv+Ut48Pn7xZ9je5DhbAINgDwQEMTda8XJt2a/v/vM/XBgPQJijs8cXdfvea/WbBeGO3WYI9Hp8H93HcHB8895pnnzlcYxmPeTveteM5exnIScwiBoNgwUQo49ogL5t/O1//VtfZ397uu+dM21KKnJZcaRZjr/SK4W6Q/JyCz9Fk/2h2Hp0KU0E41glBAVlIZEniNDFJwZIogC1GEWBxemlL9l+s6xa+/q2u87/+731YF4A2BpToQvlVdpV2Pl4Vy6Px1Nvn5+Adnjt91WKsAxHARCEheElSkR5rHqVVpMJAzRwSBEo3UGS5zLkZTJ797e0+rBMBaMz2VHBnaG2cz3OtZL3vvvPSZ9uXz3LKcwBg0Uc0QtHHEjngAkLQOrGbSmU9BIP2bBagSATUZtz9hMmbHLyX3HMGrB1uIisTN3pq2g6v5sSnX/268fv/F7POBAPQDedgkxAQqoAZkZETRBYQc4I7+U4EfFyOgGJAGwdTugGXqscYOBkkxtbG4tUGuf6kRw8+v2YfG3xExYgAp3PApCBoSpiADJAOQfGCVOAgi9yWb+R9CbRIAWKMnjEen6rX8u1TyGqBtiImwGOHslSNiWpCDZfGH6xerqsyQlYsyjFlWQWSGRFCjmVIhNB1EjlSDliU4ABapaAvQgCI0lDs0cWnlm93eI17/hRCHbQNAJsUUK02wtjYHjx2fda5AgDmSFI6m2pMu0Em5Um0LGlA7LDTkdpiZ2AHWRCjXenVHXMQFIQoJg46xq76PVd4BbSnnrfGBloR0MEe4AmUKvuzMXkpOo9ZJbGqOBIer65n+Q/L9azz6vYikCKsGe6fPzvv5O9H84BYB4Ul0NbT0+xxL5K5EECAtnQwOO9voX3q6NMHE4HTMRtyDqea8ejs7tv/3PhvsqVanvdy7RXvG6ZR1HhiXexHl9KZ9Bg7XpvBRV5/Prn7u5Y8eftmgmaUI/7j3R+aCUJBiA0UAYjFIzp+2IcT0Jo7zxJX6KBM8aeXPDxg+zVHj6/6+qN+6iHvW35ZDdvzPVGHOLYZxRuNbNDlPk9Zxhv88Gz8+s+f/cWf/+j+y3f7wxSYv/VhOZd0gAYFrQBVwOw8g9bqBf/4NaWa4Gpk4A+vtDVu9Kp48fc/Hpf9GX6d8mzvkcn1XT6lWqqJt00cMdoobDYiK2xozbd5//VXf/nux7/7gyfPX+xOFjM3NimEAGKFUcSGms9LCrQQ57xuip4IQKs8EM7S0t9LYZdfpv+d87Mxp/vsJtkBOz90XVo1RjLnO5tSumZ4hW+XSmnnYP/5Z9/7Lb/++2+8oovnwmDDHgwBMrpmwaQEOfdNiEEhRSpgxYaigGJiRsmbm0XYBUSfGZ6bFNilmwKyzuqgmlKH3ccv3e5ifeNQNG7q1Gj8kvjwQPeh918c//Vu9+/LtA/LOSjAHjjG/QlYPBgOhQUwMACTowIp0P1/2wkQoQGUrQEGNONgCr+bGRqRgIaQbdS+dJ+x5wxfji/1/0AvitGonLZNMh2NnPjS5srZmLf98JMXfXShA5PSCD7QB7oJgGkBwQKaguzfXjtBaWkMUgHaKjqQYvaHk7ZIImBRN94Ul5R+SF7JmzXxnztc+uX+5+3H+9XP3w6m8n+F7el50croX5vr+07+hcyNItIBEAzNQAvabmwSWwrIOWkoAFTEgIpWAa3ChsIZ81j7Gd20z0aDstEiXb+4lvpHvX83GQzX5e+/UufgL4XpNCiPC3z1oHN2f/+XfO9/L9MZIEDLxkW0RQCGCBBLBKAinPv6j/8VaEvAyQC0AUVoXtwM3n597+tJP38z5zN62EJ5y1xSDumW5erWK995ZORxHnmlwK0/4fbvlfyL12sf+7zrHQ7vOEBLUTCgRQgMw4EEgKEt2Z+fgjOfkqFjFRS0FYAhPvNTDt+sV3k2rhpXK9lu73DmCCLqZbLEFXXJVlOJxXMi/ZRbPC/xjutdFgO0ZWwg2BAMAbrRtEToyNun5P6/FiMrAU4TQ+7ZHzVJujfYiKBoyqgRVrIE3WCTK/Qe3w/2ov2/3N+++mwvHt5ldHk+nJ1xEaUoBes0LDYAAeusxfNj88E6EKCBwCCdxIlL+/VRB/erqCiZNHOoukOcRMT4wRk9C4d3aFM9pArOmZ20J0jny4UhQAVlw0QMsCbYnAjzqcQJAVoBiggQyZf+WY73PFQLlkyNxxW69xVKqgiu9Pnnn7na88dPnzOy/urVdGe/zN57R2otXe4y7oAGDNA20BARQRODAASIRgkZxawCsEYEaBqTfW6qTkM4Fw8ZuxOPcqSyIqi6lIfJRr8qoOnv13TyI9Hs0ifS7u2Lg0tQkALKoGHBAATUsQEMCICMLtXXgAHaYLUW5SDTxj/sDg4hayqUrRtSFCXCzSTeHZqt3sdh3+6DejctUJm1mspzXQ6bAJTRmFJAK2iQgnVA+tzTBzRgLa5ADkoZflaLk4YI8jxESXKK3lvYqe/2utsnuXIyxUmq++d5JCeH1vte/IoC2mIYACE0IMUGBBlsMKAMtz4a0BRQBzCCrUCTAiBL6Iv33/8r+QzP/wGt15vKJBuVEFb/jadT6XBsVu2ii8Pp9G+/pXeQmNkIgMkZIAowgkI4MKBNIKBbgBMDRABMHTRIYIAIRqGASKJXCmOHwNz6OaRSqiQ0f6884AXu+gb3ZdtLeX5TdZJjVutenrs/Q4O4IKBFKDSMTVCIbmAdJ3aLQVsWSgIBLVI8FEgQ3iozR1IDSk3ml/ODSIRSJVVh/OS9xd7OS7d6Hm//u3A++9LT7YMQYmAYRVRjoyCA6EbjAJjsEcPC/cSsRgDalMAlAVoj0kJA2rI4YpwU5HiqWz8dJjfYiosq43kid53FOXw8ydb45f9uFDvvtQUIIk0EmIRBYDbhsEFSkCql4dTIqSZAWyTFAA2QCgxylBIV1Jc07XxpfVnJW464Lku6WAzfPfJ/PIvqezs1n5Oa56nBARAtBcGAFqGxAAwQmlIE0GrTrYqAVrQgAEWUgqetSCH0mBgDWqmIRARVOSqV1HdrXpvV2fJyE02nw8e6d5IrCTSopkhkQFgIUIUCYpaRgQQYqV48KWPAOjBAy0BSFAoASkWNcRGH2CGdjVrAXDVlY8Vyki0eSg+iCZXV7vPOMjgQoEgAFFCKcKEBW5oYYCgCIN6nfPR8kTZoACpAGxgRgQOwBQJoVFqsE8kpAcvucstLZkgh61hfJqii04gcp2vEIABC2QhKCUdAs21jgDQuOggMkLbvfv4CUVQCFeBKxEUAgkaMeI7LLg5uftCpTej2q7ofx7W1QfWe0ITF+ppN/PqJkQQAW0IElIICkNIggKAIl4g7CATUsEoQAbrzXQegRYCLAE2IhOZQpthdDxVr3ICzm3h5vTbLTdTsV3exuiO68Vs3oy+1BaBsgUC3IiEVUGiQrRhHBBSztUsnD8r9yoEgpmCtIKKAQEA7NVNMYKRaPOSklZFtUUQKN2E+Ze3hR6z17ZYUbxEoAKQAUQSQQlYwAY0DE0QIRgBwdNdHDhQKaRXgQgBRUITiRkkhQMKB2M0edOGcSAIq1kPeGGFeFD/o11f6GA20y9FXY7xxlAEpAPIWCEBpuuUM4bIJzCLCgtgqUADtcL0TCUZQ0NYNQSEEwwCN2fXYXw8B0iMlUgWHshnSe6NDumJoZHHKmsj8MpjWVRZREmILIAUFTeBQBgjG7JZuCYHReQfaPvd/vWI1uxZViAhwMAzQ4OJkesy+B22jELwqd5CAVa0cDQFiy7oUa7BWzhaDeuquaJ6mf4yNUHARg9C4bAIPC2YxZTWf5X61gnipRydaBLQCBIQm8bjbwezcQpkSgLh2oyUtEsV0CbROObpiwAqDSjHMGu3m9xz+X/2fkVgEuyggPRIYBwZHJGbBpT2CeNXnHdnRohVSgLQiMMDu9Zu/3fnPmUNMZ1TiDATLTzrN72GFgA3TeK+xjWMaIw9Q1jr9eT783h/88J1eJ7awdBOAYCgVw2EXXCR63kf/z4LTZ8/vG5dbAIpp8QBIHAEBRLRz91M/ZutzNIe9AfutJc/sMqRkOikpFbGqYw6Ssu1w5A2Kb8frnj97/81RliUdxKMP3/3jmrNrxokFE8c4AJsBDee5BK58xj86ZmcfaItg8ACUxYU4NDu/+atf+snrpQdM645EKmw6CyKSEYlONFRSri4LkKA23zPYvOWVV5s4ZpfR2GQZhwv89+L/j+PyzDjBYQMUYM/3e9/9W/2qfqO3abF5oBlbs8nAI0Yvl386+/vm0Ue0enySo/VOkymydV6dViSVGtzqITsdjaHAO84pN5HK4DIvFDDOiMWF0Ijz6/Lfw/1HlqcDh2BD/o/jNLju+TvTbIMetox24y0HKf+oWQfKsLK/M2dHp+mVsjeqieRE8glt4jsjz3HpRAwgBk1dIxJFABtCWZZ0mc5g0oXyuMv9Rx5cx3IxrmfMq83g+vv/v8+vhwbIoTx3j/1u0oCSEJkkgsZ9sP86qF/AQPfqOF8z/JfRkyJYVYBEQF0EiUTEYuChLC4DKaMbYgR0CZ6c439Pue/HI+Cmi0+ZFHHYPHccltGM6xEQCbLRJzmyg0yULFCAUEEa+0Cia9VtEpxli4RQAosnGIBoDIABWzc6IvN5ZcCNn/X+n91H+/xCvKAPex+yeDI9AQoFKVHKQpFcRyAJCCfoCamCJ4B2AVsSAlsgT4ZHuiB4MAxMsG10aCx0Pn/k/S+bbgbrv/CTH7t3lQHjGq94HZ5ARipAgBxxAJaCLGkAskgQJUkoYEtCALKkRMlmEFOTwg6MDaixBcCzzEfe3xdg8mbtNp9KI2MW4Wbc2YQbh2iMY5gZ00KHjjQMD4LSIdOJmDwCRKRjwVhEBMwSh4zplGAS3PKujyqrxWAZNw6XCiAGEMwOIiAUEbEDSYAhlirgskwjsmAAYBZz0dWavarg9vevH6FWZywFsICKIIJJB8C4B0BEMcYIFkJEKGMsXWJ0BGxStlCXENzlRwmz+y2KCDFQMQAUDQhAhxCNiSBEAY07GsEgcxlm3hXJ24/6KQR3C+3n+CWhXYVmBHQpFhcW0CEpAhnGWAIUU0WASLAUnObEo51fs9AGdw0tuPJSv84Yrzs/qIgxHAGBODRjgABEugUDKWx0TGXLqozud8/jFcTgaYQYUrB4/9qa3Yt0rDi0xIWyLRvRgsUENgjIjWpPVcKVtzsHBfD0QgHOwbJj78H89krOZ5enl+bbk8AZpfXZ1QeY2Poz0sBqOy4PlbleXyACtw0=');
+    (datetime('now', '-1 year'), datetime('now', '-1 year'), 'amyrobson'),
+    (datetime('now', '-2 years'), datetime('now', '-2 years'), 'juliusomo'),
+    (datetime('now', '-6 months'), datetime('now', '-6 months'), 'maxblagun'),
+    (datetime('now', '-1 year'), datetime('now', '-1 year'), 'ramsesmiron');
+
+INSERT INTO avatar (username, content_type, data, etag, updated_at)
+VALUES
+    ('amyrobson', 'image/webp', X'524946466A1A0000574542505650384C5E1A00002F3FC00F104D306CDB3612ACA6F7C9B7FFC0774344F47F02D29A4C6AF335AB3FD5192284F314911327525D01EC9B05A3489214E531BCD6BFCCC385BBD1C136B26D258B5300FDD74548E8EE12306C1B495114DD33F45F27F3A8FF0144F519BDE2720020DFA0FB739BE392FF72F8E8D799AF8C1F77BF2B7B5E509C1813274EE2EC7B26A5873D5CB1FABD63BC56AE9C698FFA881AE7EC4DD626BD42391C5F5FEB97EF4CA09F0F7300F2DED6F6F02F6CFD49FD6B602068DB36317FDADB7E081131017D658A930CA523CBDAB69F93247DDF4F7F87323292952AB76DACC6C66ECE617636567D08E359DAB659AE7631AD70C45FBFFFFF877667D511F8916CDBB52BDBB64A6DADF73126268005ECE03CA4CD12B0C52DEDE4EE5C6C756B9C734E5760834C8C317A6FD5936DDBAEED4A9234E65C7B5FDC0BCE84732E9292A4480124271591BAFD0A08CBF294E439E74C15780FB8F7ECBDA6146CDB96AD39BF59C3F90F92BB3B696ED5977C43DB5C1310DDE2E42731F74AB334480E8983C8070773DF7E456E1B29CD0EEC9E18DE403BB66DD5B632C69CCBF6DDCF5DB0244880BC09824F777739B6D79A92060537921C49929947D5EA476FF78757DF8DA80A87D836922349AA9E37F967EBBA2B02200070503EB59C23F76E777B6A55A5C7DEBAB0F1BA0A144A0511873EC53D77FE833F7CF4F32FE5EC2FF3F6FADAB82C6FC583A5629973AA790C20047053F9A5A89DCA9C5E1DF3F0F3E5B25B83A04A003E5D990673296DE7FD46F7C33FB3E7BFD929E8B21D4D3B1A7414ADC44233BB50E7D19D588F01A66F568B85B8ADB65A4EC90DDFFDD73F55819E4B6605F7B5A00E2BD13958B7D597B77EF96659BABF373763301FE3BE680B3B79D83A6EC62DA886DAB4D29B5C350FE16883EB7D802F37213BB7FF2FF1D8FAF02AD4E91207D534E52DC188220C7884A3C269AC367B6CABBEE237572BB41A65623C365DAF6DB4ADDDDF5A7BBD773AC4C51C5B9C208942819A9B7E8EA1E2CD18B8D1CC42F0CB6D5FFC6ED6BDC97AC3E79D216FF48F221C5E6ED6B683DCDFA25111EF2DDC6115F68CFA6C348C84E869238712BA21B4992C098E9219A3568CC23A308AC5AC12D6BF7A7FFC9BBC0E6EC87A974BC6DEEB1E39D160AE2F8A82427C45984DA6A46526642AA5AA70680679BEDDDB78E79D7CBDE973B19FC54B5BCA850ED98FC167F063AB559C7B380C0263C152ACC2954984CB508E74E7B9F1BD17D26FC046E3B273FFE1DD00E9986820B5C82A8008EE79B1410A586C602AE29005D92EDDFDD3EA3B7FF3FCE989562E34B66D95A4CB4A6C0F597607789C4479B84EBBBC8B7FC2D6B4C11E395B254D2FE6D8467DEB2B3A55F9ED4EFA81ED85E59F0DFF7A9C67CD3E64A7ADC65BB5EBA782D303010BD53BB143188EC068C78FCD9D77AEC4D5957CE75AD9DE7646D3B8371AD328A29576574631A55F8785DAAEACC3764D91DAC611451CF064AACC36D497E2D4A1042C82CE9D889E8507CBAC3ED8FAFDEB2F76FFA17874E12A3BD811C9B2A8DCCC36ED9DDF58BCC24C3DE18328A49872A4C77EBF53D6B61E31ACE9F93DA4CE4C9CF05668836E76C82D605BCF81CF589AF7F284E7975A3E1497ABD3F57FE47952733389A8A27C29C328DB81FE40B118AFEE6FAD4F6F01FC7B73FBFEF2C1D6AFE4675E13FB08D763AC3E0AE649324265942EFD0D9DF67FDC131EEA8F2DED17BB79152F576BFA7FEB5BE932C91C94A6B43D64DB34EE6739014845E0337A31F992E97DABB70DE6265FDEF086A52F9B892DE3904434F6200732CAA533AEA7E15288A5FACAFB8FFCE09727DEBCFCEBF86A777F9C8F8C7824CA7B0D3F32F06BD44143582EF9DEAEEDD3508DF8A691055AF14F67ED5A5E7C95B815D090E5D958172624A66480D96848E9703013E1A8D415CB631B8C4D03360FC1D6F2B1E9083E0EFDE3903064C036CBD3FA59384623FD00D7DFCC3EF6F8DF86613DBDE670E3F39E7549FB13BD7EE255044B34CFDBEE63109F1CB9B533FFC3B98737424F2B793691C3EA09BBA758E7622C8966EBD49054316128B3166388061A4503E672BC5BEC25050E5B589B71E80AB70EC37DE3447F8499E146D9582535A8CA4CBD690488BEB17DE3F8FECEAFBE7E70B6E8EC38DF3E491EB2A0B796177B518F09D9725EB5D7337FB918D516DEC7DCB9C08301718EC559CB2E173A97533974E7804207410F6883105E03ABE2D9FC28036D754CF60D023CC29D06B5455EE468645EC96D6BF4A1E7650D6B39B69A6414670C51BEFB3DC1F500C0E41B59EFEA5DC653E3870332CB6CBBF316FFCEDFC7C738ED81479A9DD2032ACB7B91CA3E6197ECB45D7DC07417BC1759B1D3A78B79F21FCEAC6D606CC5521026C019D066A309158A719F393578329A4863F3AAD8662A17AA45D8E85E1CDF8B2EF7F45CACDA5E2F2AAD17D6F463EF8BAF130167B216CD5D0FB0FA06BB7D30EF468A750A40D1713CDEBBB83F64F3B959E1AD15A756B9D0C56961D3962CFD111B7D6B4533DB30A8E9DF3ADBF0099FE02E5D6E8A808D8680BAD8804903C09080C1F59447E80A5AC5E9086A2A632161906D7E1CC4F31BC5EB2663A9F68B7BEFBFA696CF043E593E3EC6622A00CEE44AFE6F3740EC75FAF5F5772FBEF3117A41BA0FCA07DA4A10CFE43E7BE2FA85689C7847E9875CBFDDD265987DBC19F91182E87E2599D476B63325A5518717831CC8D4542ED4009951139A8C4469A1148C81148C5A10177C0E5618EB5E5D0F85BA98F352E1668A8BE5F642234F16FA872117ED7D93AFA63C702218C1CA0D00C3AFB5BFDC7F3FB112F79D068B96F0CF179EEFDC767EAEF3FA45AC9F8994CEDB9BCA666439A93CE085D3994E1B315CE0918D368800212666B316DB0AD0428A9D50612A0F243085CA426709A0DB60962D2A5803B93D7D909B9BCBED2C756FAA4C96B48FA937261D8508DC088C79293CFA0E05001F5FA322E655662256566D31115BA21BD19ECAE24BE618FC6D693F2CA2DA7CE27DFF1B7C0DA3281DCA3627B106B140478142344535C97C101287C11C625A641406A8ECCE9B22CEB8CE1C9A538A6D8D3B2548A0293321616E1317A3C3D81138C3240830162D58C0191D00EDAFDACFF8519CA7DB65D79BD249A60F5B8A3F09DB4D335E73C7A7B86CFB3AAC1E277333A62DB73FB7636CAD80AC5656B3258EC0150800E188A68C5B23DA6A7390ED044094CAA6CDAD0C608772E71A2E532D444D888201E2770B7A3447343673B7408B5518176B6311D672FED0A6779E99AFDE43B5EB572DE0D7498ECF2C05786E585F34CB7BD3F948AD9EB2DA7AF1567F3CD2759118DB0A1E2290668044608085B02C611B78B664342971110B8A16AC2CA2EE64AE0222F63889AA666902C0ADA838B58A10079834CE36D987A91DFE16E828CA5498685B696C58CA1C37017A5F51DABFD820F76F983E1674A9D7148C083D380A579011DD0636A7CD421B09E7D01C662D94ADA4890860636D9B9ED1698354334388C5B652BAA926D9D53C1680472D880E775CE8ADB97366013BCC26B9432B0C08C2AAABF904AD361A6D30A02C369A76B14029F300A500007CE7EE4FB5E7B694847CC4C3B569157A48E229907982C0FAB55C8AC5E3646D8AA761BE8A3FA4A8028AC2C103E98E71B5F224793566C990D270059C1384ACEB00DAC8BCB5BFA56303B8CD995B8B82D0D6808B8CEB49B395093785022F508B81D270A2380098150C5282915307300DE0F035A78FDDFCD3F5ED636CDE400502E6E6C9029956DBC2F6260648655A2A73548891ED4359907B4061344CB20D58FC1C54199B433C834668845B8E977151A964962693FA88365508846D8770F4FE3019A415E510C0C64202B52C4D9B710C478BCB4A43110A68EF3E801B0026471D3EDCA7074E779ABA278843718F176CC5280758DAB98AE776694E4458A67232866889625A2193A3254F5BF7229E0403BA82F692CD96CF090EE1EB21D92D97D980AAA365C87F00289D9923A51FDA4B22F1F1150992B631C4C1546C4AE265341683C3D20DB4C4319420A26C70CC005458FE16698E2765560465A02684946311440242A9435EFFC7D38D10120D1D9A6FF99791551800DE0C22CEA57AB6316223EA0E19C3E15B739A8C37B016240A060A376250CC91044832C19087DC52163AA049684A3DA644A3177B14E71EE276E385B49E8D56C3263CE163EBD5B1D1E5CF696B772FF698E375C02C2A22DC070CE30891A04AE09653DCDEA6DC3A2C87900B8EDFCE4155A01783A9190BEF0CD933648FC5DB5850435B5A4815D22DDE61DDF48CFC10002AC2028E600C2A17A193192D6CD03B9BA96237B2A7456B4B763E6AD8D763B9CDCD108045213682189BB24EFDFF50E9FFCA0403176E9D40592FCD45083004A5341C1BB4932E49941D90596C8595D57BF3D81AE7295D87FD4366DF94FBAE363E4B1956AB8DEC2DCD29AD2088322CCD48088269366DC40104A4DA655348CFBDCC5B6DE41D74F15F88DAD3E68FE61DD94EB0C50A8C010A521805CA566D66B830715186E7E9416D03EC920717104C092C8D1E67F738DA897FBB45732A67D08A23D7DBC2F596F543631E3CA6FBDED9D822C1DBC8AE0676B9AAD63D6FC8CC7E67FB780AABF84BD80000A2023266E4330CA1AC8B581428A04D36A049BDBDECA227F8DCDF277A4C64DBAFC85DD04DCBB44CB91F437301092B2979004372A2108C0404328CCE70FC40D24A3B0E5734DD5DD0132B6BF4917F5FCEC4E930D70F87EA9DD65FCAFA979B94E9B7DBC5C70D9BD51447EA6A798F242C481482050000302005D0B4CA357B0EA2899E17D4282169D211A37DBD2C4699321C0B930030050CB1B3A7EE0AA59656D6C8A55790694518430020E6C8BA6C6B81F20055B9C761548CB1376D3082A898089E100D976F6ED229AE4EF624C95E68985A8A536DA2DBEAB9C1BAF4172911C050460A252DB99B97361CD08A687332D942371F9C20B7E65C3FE93CD864069BC47C0B22C66185825E691EC41929A5908D846100D26632A8CC386C03F86A73D8DD7DBA32313E40538060051E454D516A748B4CD638E3C37971FC451C0BB8801AEC518C0219E55EDC5BB8776115FA3040848121A023880037D7D53399400D261502B0DA4FDF3FF280430B5CAD14881196F91144D9C0208E2A5CA20914A281451418A6ED192765C6C33F6A21735AC981D502B2ACD0347C15C2813BF0D8EEFCB0DC7E41FD2116D6A9DA2C3DC22ACA145372F4EEEAB66F5F39F79033E44BB102012A64490FEB26A0E86A2E334594294802942D84EBAFCE1DC6318348CA8C529B346CB40AFBB71214800A1D8280A901A2642CDBA9157F5A4A27A297432E58056D27041B2D262451893B83600B7D7AA297130CEC2826F187C2DFFE6CE92E46987A471EFAD00E379BFC5A0B6E0D9B392ABC0D46D28B46FB4E9AD08A6D32A879A6B542E4C1B69DE67F72B6E4B190E6842BD00480229A65E2A52F269C620882A00C1560509A63B9BD1617786189C311AC37340020B876B5E069BBE31DB9F5C3D2FABC3CFB61D452246286918E6BAF30BBD4171C3D63EE649CC56D11DC839E50B0492F569B4407B315D3863E1C13E6369EADCA4A6CC406DD80430ED173A096649B480053087B03425F50616996060005233184541CF37697DB26D6756416159657B3120468DBE9A8DE59F8919467A165A283758976448729216ED15E652622EF8751DF4A090D500960A61600E9041D60494A4A6231C733305A015B9003DD3E0E0048401BA6042DA83061D91C5F8CBC5E3872C1312C4D390CC8893CA125F440E0785B965B43FC0A03FC09D0048AE2C0858B48AC9C3071D23C0F7E1438841E310AD123D28AC1A90D724B43B8E1A4608355006685A4006A0010D4613DDD11ED4C7AC276E18594A199C5373060044CA0010EACAC5BB15CAA49354705DC8CA0818E4DC864A061DCA5B854C80D1D0588E6610E66651865F7D2262FF0007BBBBDE31195516094745986E7F24C1FCE749B7C734325C28814410BB10014A41562A421B810C101E4A1929583C1529EE5C4603B78D8BB1B4488754335AA700D40826099B09688CC05DC0D694A524A18A5BE5B384B3F0C65BB8A55831A3E325A456B434024230FE3BDDDF6BCC13B5374B9009A3C842D1634046311CB4D7EB5094A60AB182C8206B015526105010200084AA01F1093947CB08C6A68A7FBF1B0B5D9AB2BC7620963B118B570775B8AE610E1162000A10E0E4A8551D059A84BAFE0211C11612C4A11A6648020A60BD776E3D35A1B8AFB8748B368D8ECDB2DD8169D2D59DA606D96E0840E6443CA21A4842088942411401F049474CC3C40312EB549E4A1551BB75378366C4104A2A002517857CCB1DC63B988711200B00A831402D064824842B636C655728CC940229B500324A889626BCF1862C17E336C4255AA2AD39BF9A320927589978D4874602819BC42EEA667688346210D003488242D106818B88D400B854469394E43352A61000C0BC0C4F4CA1840E879CD7282D1981B25AC002A108648503826A4927A2B41DAE681F27EA029E9B67C9C763EBD66ACCBC0ABC2646E35518212D7B01297FF3FF2ABF9E79F71A347DCA6A27553B58871A9448933F5E0A8E2EC75FF62BC3BAEA1C50A82C6086A438CC20C400820C3A3A96C8C8C2439016A2A168ED08A3CA1D31ECEE1F26C9931D3EC510D60492EBA4ABF790DAF045864F18750078074B17E6E7B153F9DFD02BFFBC9E905DC14234ED050428A39A1A04001D5AE196C10A7310F20E03040A06DCA03030498601400002B1E1BA085DCADA4C57953E452DB062530973924AFF2CE94FF4D4CB6E1F51B63532B88F788FFE2B71F3DF2DE266CD1016164C24694354A87E9F3F0CB7F3D2E342D2320699FC7AD641EE4749B021160D50DA3E2464B7C700A195EA1C609886602065840A61E030000305D8931DFC0AA31851A1A73CA734A7350B324209F71A19AC46E9755D3FE0FD739B30400A9E1C7CD9D5F5F266E8D83D436B064808410C5A025AD87CC389DFD0764CF1DA38C497A41F10C1F00BC182EB20FEBECF6F1E7A3FE4F96500065680160C4FCAB5F61532B9A49883787E60B2E30126AA1D802F6A4B0955334F1F3BD7DF5ED014239A30E40E18F0BD264F24BABBD236C41D85B2D0E41882DD4312D4529DA2890C912420C4CA65A00A8659AEB8DBBB6AB99CBABF6833B05D00422401044075100AFC0EE5FDE0FD59B519A1B52863507B00C1015931612C542C506B285643784145B424A8A28488A12E50DB56107EDC2B529440A42F1FA098F80A60D4914A0A680028111175DB35D5DAFA58DEFF592A201000854A3EE4DF796CC5705F0A5110CD514D8CCA8110C11A742C91853A120362B7BD7697AE70D4F806C39518640008418BC870E948609210DDA22B6867E48CD4247502AA1589210A210708000B3D62ABB9F54EEF30F7A5A130CE000816E0CBDF61B4E7C37D00FC29CF03026800021650E74221724C08A5B2C1FFEA09B8A00EB625446182D21C06B828BD06C8A2809C08AB6D39226788390103635D005B043A35300C56C8E593797CB596E7DBF8F0E30800022858D97BB0100AF6577A9E312222592182A0D1D692BB924CDB2B3841596F7E99E8F307F8BCDFF43637C3134546DC602338811A4D4B289108B28151444CAC8C971CBBBE993826A01000054E676D32E80014D110AC128E05016440A5911021124C1D591F2FAD1F1D71980E62341F7988DBD69DD59AC8C104A22C3BC2A50B2199017FBFF1FCBF9BFC7FEBA811694A8B9580B65A8BC9CC1A5F0402D515704D5B8C10C862D753CCA3AC8978B44F3B0160B00006DF4BA680C0000EB860AE266C258888AB2A2AB056DCA4D8342341EFF2FC1EB05E04C909E1C233F191395115B71C8E882E18EE1DE56BBEDAD687B48DA0018D3A064E0226CCD212B97C7BC979223A6E03B84822AA8ACEE32BDCD877A2CD6609B60810120331A740C580BD000A08478D30A0C0984250E77F6B644A0408BFC6B57EFD301BC1176900E6324EEEFDEF1C22E9DD918300B6843A6489B38E7F057F84DEA46A4E2CD165A7C4BFD28EE9C3A17D97B1611C22544428401D1AC5C663886C49B6C2D060825AC4E889B010040668004A8C1128B21F7AD126273C4B066628F733A8037E6273FFC78E14EE4517ED5D88189AF5A16E25E22D8A5B7A08832C6C67D9B3A45B79756B5D91EF94038A6E2B0DEBF0FE11A4C260406A8C0C6049447AA8D28235F9EDC175B0D2496B00610003493380A4D3334D660FE7776DBD372F2CBC6AEB0BD9F0B01BC199FFCD1A7FBE4CBB8E63E96700BDDA619DA83B53D3B63673BAB6213D5C8D9AA0CF52CE13868A1118219E1BDB105087C8B835001489A997A1EB2ECD97EF00D6253041254C6722868DE9499BDC42EC337E26ED2FCDF058EC89B74FE08F5A47E006FCA47FFF09DADC5EF7EF03A1C3AE28D6262B88DC33BBAFCE610BFC1D2FC80CA52A191B0DE3C1181D82578AF4CDE1941CA1416C5094349B69C286D839E863DBDAEBD7F47F344EC67A436B83235210AC70C18A83D956B379250A287217C69C1ED61EB46EFF3F5DBB7DE5C00FEF1FD9F6DFDC577BFD94DA96BC46545F1C48CE72ECDEF9B75234DF218CC90C837CA20FB4602AD3B1C498436042A04C220CC43B929BD7BA11D5C3EB1C8B15477149BC9B4E23AE27E08D94E7E7ED8BB7944FCF46AEAD1369741C7665A88C7F4DFFF9DB7B700FE1D4000FF00AEBB1B8D11F59B223A5B27C34D1815DD0B6275ACB095B380869832F5EECDECADB456F3C28840243CC1539C44B088285125DBD3EA9FDCDCFECD9BD5AF3E0C2F455633A3F05E06D7ADDADA074FDBFEF71E39738AEA5ECAF06F0C5A013E0038180051DC75A00B6B03A2BC46526211B90819C11C64841AD81F652C6BBE69D58EDA6AB53655A2049220441946A610E5288DE6B2DC72B7DDF5919C6F51795BAC1FB00318255ED17EDD26B881BF7C4B278BCAAC41E338800303304907F5FFE94CF6F2BDA143A4784CEC28C90E394CD9F2D1506D628D8AA5A2A8A955242112CA4A05605A410C65490EB4C61325279D9B57F25FB5E030A726F1FFA02B5E5C2408A3EDA5769D26D5B5CF02B80100569FFADE2F766FBE43C33E2B7412510D276671CBE0406788C4C862D50EA7C275F80EAFC2AF701D45020D22886AD2C28EC79A7E68AB30B8B582C3ECD389DEC4E8247AB1176F1EEA1E47781BBEFEF99511003704400C60D8E7BE60A8EA28A44D35A51CE8D4901054C6AEDB24671CE3866B880A4E38840C4105250CC1C6D99C1F4A237A87851F0C1B7550AF536DB3B8851D6C4E790E5D79AA1100370CC0478076EFEAC8BC745537AFAEA7F91828AC0422C4AB93707F8C1410100C013A288D00ED28E6D9CC780A90A2E04E2C5E6373998A6301653ED84ED152FFFF02C04D01E00B402F40E9F247A7BAC55BF7356EC60C866245C1990F84FB5350450B1A31041825D11D657E7F8184996ADAC221D60A66E358A3F44FB7C7003F00DC3C00A6016E0054008FD292E214A3DAF878F0D83F9E4DD11A2B1A6D58F45B0A097CD9DECF5F1CD514168FF3E8A5D863AE36D7D8817B0800', 'cdc1ce44bd01eb66', datetime('now', '-1 year')),
+    ('juliusomo', 'image/webp', X'52494646C2180000574542505650384CB61800002F3FC00F104D30681BC991B2B3F76DF3FC01370C11FD4FE240937D86E36E9529CB67FC09B567B4B393204D1259B08A24D949BF4814807F85F745061D8E1B4952A45866663830E1FC37ECB44F87692439529DC93FC60BC1FF3743D1F43FC04FFCFFC01E12807000057222F233F275EDB3DDAF389E7ACAAEBE4F1D0548360CE5A6AF1061322B117949479F619455DA71C397BAF3F3CF473B42E105D411BB761119602068DB36317FDADB7E08113101CD0AEA475041078DECD8D6A64892F47DFF6FE6EE0109CDCCA4750FE83C23E24E46659298419B0DD468B483919B99998A93C2DDCDECFF9A33B356E04B9264D5B66DDB32F728B5B5C18B99571A38432B7D2B11CC6B7DF11733F5313A8C564B84FB9124C9B56DDBB6CC3DB2F7B130A297EC0A6C6AD3BB26BB6A98DCFCE6573536C618E3BD471FBD85BB1C6ADB9637B9FE93AE3FA71698324A6DC3DD6777777767727777D8DC61ED866CED94B4E7A4C133D505AF9009F0A76DDBF2B6D9F61DC74917C9225318CACCCC4C7FB6A3E8243A8B4EA13733333396B9B6437214EB225D74E2310D4F0220399224C91611B188AA99A3C46095B9CA4BE4E5320B579DB17618978332A82A42E45B92244B9224DB226611758FAAEA7BFFF0ED63FB03AEE1612AC2118000E0A4CFBCFA77B4D6DB1828954593AC6B12A5AED2F142584C586BD53C7F14AE1F8DAB25D486EA64FCE1DF759CBE0534035CD1FF78F5CF81DD79CDEFE3C994AB240209210115AAE98869615A51B8CC5A4D817EA3599187C8E6B9378DDB56933C00D45E29BFFEF45F43BCF56AAF2EFFE3AFFECEEA400A11672509639B1A1B8FE2A8ABACA2F832E955F5422FCC25F206FDC4FDA489D851A5FA46EFE57C780BF0E30AF8E387FF5938EF6CFDD58FFFB18CAF5608A00248960395BCBD8EB7182F7AB443AED7B19C58C57CAD85FE0B7A085E2117F693E6827E835EC16A487561D41EF6A5B870E1B40441E575DAEF7EFE977D7D1911D91A90016060CF78EAB027FB381C5C733DD6359225D6CD4E4B1EEC2D639EB678E8F937E87FB81FD40FCA1200D182EA5201A8B99B33EDD7FF263895BF7CEC7BB13C5AD1F911A54A120C42881C2A83D6AB83FFE7E66C8F7EADEDEBB8611D0B8FA6BAB66FF64B7AD6B5B1BCB18F14AD3DFFDBF5FB6FD6FB120904D26AAE5A410120F91E9566C5F7BF7C8B9D426CC5D210CF6D19C432194294F25C187B785EBC7DE2E53BC31E377D18EFBE436BB9EDCD8B6B563D5A917BED71ECA6962B7654D6F5D4BC58DFFEE96B1D49C191E615BAC8A11208567792544D7C82D889C53859A11084B17C400A000560F43877DAFB919B83B66D7D91451D97F7C1A03B63177B7CD7D99B146EB4D770A9AB2F97F55A8BD3DEB9D9033B1E04BB5A552B5854C58228D5A1C80C66281C2179326720F2C5CFFCECAC85E39E465200860500118B3B04827A195B0B614660A5999B3DDE6471F5A2F7570108B287EA1B3010A5C9E3DDD4F557EF87952D29A210460820A00120501650504E76BCF2EEBD60FAC3976F02F2C73B0391A73FFBEB171E2D35600111044050060000804D2E0EAD73569B61F575CF77F67CE307ED4AB21118379388B4C5D1E0663375F6E2F5D2D87E6109450CA20214A02079468480D640AC315A34EBDCFACE90C5DD570F1E0BD0F7F4A77F7796B094143004402003861200829134A8921DE6235F78E69BBD6F812FEB1AB35D320A5634C9A24844443609660887D82FE68EF536784B1E41BC4470200E698224D4084418251A8F7823DF8D03FA8E33E3B4CAC7014040089B30784883630110C4A8AB712FB1234F3FF3541D45354B246632C22D8C636743244095854012128734E491DB5F6FF3EF038B7701ED81C3B82059C8684404A988C9826416E6993E86DC0CA05B512480046928020931020F004626408B54D0DE747C3DCFDE501991ED2476BA879BD851204D12C29A4289130562AF854E6DBFDEB8FD4EBA02152196D1B2239030162EC9801912A900ADDCBD56339FA96F721875D02241846498308A1A1E5020805904B66A80C6C5A393511CB0C411088779959C131904111343510929C66512261E62F8FB78FC9D4D5ACD8C6380914C153150C6C10865B444D0C622B6B86DC700B9CFF0B36F7D2D67269BA8049A1002278C722AA59CF2140300A3E8435D9E751254DB24B48CC426262998191EE10C3427DC51A4A8C038322096B46D6CFDDDD1BE691BF2B051591665D441849449A802D24034D21961B2FF7E47EE5300F99CBDEB5569452E44A00489342B513991280F800B18E018EE008D20D3D01C551A3191517B78D9D304486015471448208990189086AC739DFE26FC4FA4324F801CEC59F430994DC9204599C51A56DA822A359097BB80FC27FD0215FE747DB1CFFA10A6886C44E89287A588B82F20606E28138B434A28BC57DADE336365F4E0AD9B499900B994B464686A5B553B91D66E3839574844592E4F4E5ECCD5B68ED3DBFB989781F0308C5AA6C5054C59CA629EA3E9E5E4F73EFEDFC24F8A076FD533800205C1A76851065430E022420109036001A59643A1024A527B16E2FFAE4BBB583DE79FEF8DC75FE73AF1988B35BF3E3C7BE7351B270FFDC0E4F6CD2C52C256E2DD8063D9066D752CE60A20142811602EE6420C19E4A851F4523ED8FA09AFBCFA4978EBD3F797F9091061634CBE81C0081540DC17008E800A22CA4AADAAB3D6C15473CAFF93832CA664762DFC5AE78F79429DDC773DB4777AA2E1DBE01DD4D2F567F267F5BBF365A958162E0F7B96F7CB38A83BDA18504B5AB116FC048934DA486B9BE3CD137F093F76B967F536D11620A41909A3AAC5AA8504801800101816E21009256E55DEFCAF3FD32D197DE5ACDD8D79DA5B966DA1E36EF0F13ECDDEF38C3F7B3CA5AF75DACA3EC1AC09DAEF41DF946C22202F119BF2D20C959820411E0C9045821A04A1439116175D7A75567F0C95E51AB341CE3B06DE91300F9AB0193932C21781901141064A38C2A2B8FCD1A94C71E3A733FF86FAC6FAD4C549DB2FEC7E96D9D41CBF3CA5FC69728A7D80796F9B1DF98BC1F871B27EEF5EF6E2D3FEE0719D4AFA9015D0CDC352380246E4862D0C6C0A58D8541565929462390000C04FBEF2F581BFFCE9471C0CBC63E83B0392C8C01C8A1CD98E8846454414A6851F686774F8ADF173E9AA977EF8D05E97179B3D93C8C367D5636475BD8F1ECFFDE496B54F5DD33F9DAB9A87BE3A0F74C5C596B93FB9A3337EF4A3FBD713F3770C26CA96B1CC312994AA963A6613116BB1DAA22E4C012ADE78FDC5400080E97AE6E7730DC8901740AAA2C5142337A6270C8E146346780080970C3DFD865FFDF1BADCB320BEBE87BBCD8A07BD42ACF13C5DE568F2889A839BB0C7974ECBF7CFA3B8B7D1F370EA56E9DC673EF2C609F38DFFFBA3F97333E24731121994C9553CEA04C6AAACC826D76C445972F493F90000359E8CB21EF308B29A225C862447CEF0C3302FA68C99CBC461B121748F7EF790C7B1E7788BCB51E8D9242C47AFE08CA62E2F8A553747109D06BBCD6A92CC4829114490B04E2ADA3E8B4522259B79C9034914E10CD74123FC20559F23E9C81CCBC855E05ABDB8A65E2603FCE01B5F447FFBBD6B4092992A28390892E01CDDDCF8DC599B6929773531FA21D7FFAAFDCAEF3C3D92DF1F5B23158E70553C57A4ACD22A19349656A05A6A32D9E25E1E0B2525A1D148C55D977AF1DE17933DFBD6D7FAE84FFF93868596F2292FA346AFCA75488317F83C9930C48C630253ABEABDFE4AE3F6E3676386753155A5CABAC8451F14D877DA4B48C8780E8E204BBAF3987C7C60FF3ED5BFFFAC3E814A7107AE6FB2C228A179B45B70FF7B72B1BCCAC7D079EE68AF73A5BA8D0F7C6A6A0632F82293B4B65D9B6F89DE5B0F4DAA52C192D465292019C10A32BCB06CB84619693C4D4BC1B867F435E74B2575D098252D221F5987196DD78405310CFA8C3C21AC01C837EFE93EFE95DEC16F7FEBF414436D4DA5F192F08CA2E31B795E9F82B0DF82719AEB582FF4149C2B48DB84D2E81699107DCF1B53B97E6B381D5E6A67D1C2AAA621070372B80F73A588338277CDBDA8E204E3CA5E27E9B13411A09E08DD800A89102A12C8129348C28C1C89D1697EC8CA3FFFF7F5473E7B60E5F595BE120DB676A59BBAC3990C9FFA249FC5E1CC03C73297FE4FF7260BF51CF1692D70542CE5DB3CA7DFF9FDDB81FD4885448ED1D0A53C48142314201128B7DA2C110B928CC8935CB613F28A3D30D2B0109AA181656521979910401149185152544D79E0FFDF9ED31DAA11CC90990C62575FAEFAC4E54D695C42AA4C5DC35DEC49066754AAA9E28444525E592DFACABE76914CC8E50A1A54A5146D3192817BD42090E64B49348B0640124924542A091AB0980A5301E8A20512A442C209D83010155A71206136A6BC3E61754C9890B0482E71F7BA3A230F623ABB21E9FA6A4DDBC69FBEFBFBBD097BB74FA4ED04468727FFE9FD8BBD5AEFCD7159D2182492CB2411A0E14B8C8A485109C95935988F0329E894F272424B48C3270C840D0904129A6446D00B650CA800058197444AB8B6175DF73CAB97BCFB6CE5C751A1C2B93ABF1A70B29382504EC62C0EAFD65F38A61CDF633BE5CB37D5EAE21FBFEA4BC91AC61896F4988088A930254B5EA0D0522E316AA6800814AA5E0DEB19C20D816043180041604DA2460D34ABC8CCC854A6BC8C238C568AB018C4F04E5F72C84FDFA5E50DE846FB86961EFD457609F97D23E8E60BD71D9BD6FA1BF539C46B5D54DC6BB955A1C4358CCBE5C4492C049484842EA382822C1B6E60509640B059F38B357D54A16A69043605318841204AA904A950D16242B2E42A4FC00063C09C0A10D422379B8DEA806B90CD12E48067B597BBC90FF4DAD343262D114E1CD71D82618B18127ABA5ADBC568990599C52061C2288E388A0541B20B089720515238A47D02F51AED997EB438E42A34EA548B88309904AAC202C2914484044646B28512002096728458E5611DA836904FE6E6969B8E43A9A4B31FF5B3BFB4D5CA74401A2E934E40518D6EA920039C8D1456016385C80A1800245298008BB9228080425D52ED1F072F6E3FD857887341A273888848A82898C0034154562890D1639DAD23C440CC0232121884A6B49E4A3D311CD80A9A06D73BC891D7DA1DE4ABACBC662A70031D1BDE477A4DD4D203B1DE9EB6418340A451496825185FD2A92CD552B9842F65D22A4B3FC6676D895169EDD2944510530015B45A95AFF002AF052C531EA34431439ACD1A100C8120A86EA4415C292B81710CC9411B028B450840EBA9F573AE797754972B2946A306B246745495AC536479C170053B4AB03913EE02E53297C4CB982296B41CD6B3A1AD49D408BA5C450425A60A83AE745D2E4BAE34E20212CA210044A05AB02B1097484556D62357A09E8B252DDBF9411AEAE14D10751389B9ADE58CAB81424A7008110EE70661B82DC8E386E533A6153229124186B861357255738D2FA57BAC2E6AD89732AD36D9AA34B06792A89482CCAE922977E5119F0A0329C914934D29459A462DE413F94B207D2389DA5AE8E04CBEC9C056AD8395795DDE7FB60EDCD2B5ED363B2D07144E734A280CB00002B281D36A1BBAC7F6B2E909861C2E0C552C2B87E07D2D6FD5DF923977495D9B547A56E7CA3296F9B5ECD9C81939C5185444141446C3825210B1103065C2DB7973D5975F4DF53F6009B29161872D27FD2E3161B96DEB00CD1A3B8D90776F6B63B492037EF16E0E232D30482229AB9515707AA9F7E4CA11BCEFCBDC471BB1158EE4C033F4DE75874FBF7FAB679F8C1E4CE0BE31CA546658C00124021834A1618A890A2428098858C9E5DBABFFBDFDAFAF7EE4D575A36C219C048A9BE521561FCBF5ED95F86630E4A5B7336CB1602805AE62500E36633840C018A9C4506C582BC038A4A71BCEFBB7DA3B470D4C965C1D6227F9F5E3BE0334FB90CA121E922C5D6140329290047290072E20870D2C2098DCC1156230ABF9F3F97A909D1C700883B33892A1893AB269B22AABCAD4713AB24CE8944688A98D8D385131601CB0C0821424E03212A29A0C440B8E2CFB7A1AE75055DA70599EA6010086CDB92192B02CC93506A4071631E01143882062814D918BDC041344DE7604BA238D5AE3240EC741102AA0396BCA7A45B362971AC58D35EBEEB27BFEAAD1ABA311210078428E07A6A20C01A8C0842070C2C03E7120F80D2C00D0540300ACDE77501FEB467224CB32A34398026601102090581700A5800200806D03D309566A1341004B00793094A99568E59B064697BCEDB03A3B387ED7ECBF1EACC4C326D81559A400220D26002441840455063AA382BD59A3A016CA86C7F300000010D8A8DEBA18788A8012003018000010E114013200318825B0677366EC4FC3CC238202E385978692561054872DDA6DDB1D400FBA10060C74705CD09C762C464C422E214E48028A090AA464B1945BB2AA51D3C886A39DD403FE0000002A5DDA098634A42119184C010120208518474CE0B04AFAA0B7A5C97C72FEC4E5F44EB782080A084001318AF75DAF4FB58CEE971479234C5CE4230A34B5B22EDD77BB9FE1BEB28B7730210FEB82CD124C790387A4C44232AC9ACAA6FDB0A9FB3D000000C0D9EB8E673FD7B6BB603203B43105C001010031434CC0B872F6F7F8FB10E0F1FDEFB8F72FF6C40683514048F22584244DB5AEE8C71C8CD57703B1512F2BC840EF2687738EEE8F206CADDD5EDB4BDA2AD92C65120334524B62AC646B035EF0B66FFB1F4F010000003F00C5891E4C94BB12E114240018330A985325712EF2FECE9F611A3BDDA361ABBAEEE43BFEF1FAE8A9925591833200D7A4E68EACF0B68B7FED7BAFF3E617FB76B3F61E8DBD363329BF47FD0B398BCE0985D6FC67FBDC7391AB0DF5682E0A2E732477747374BAE991456A215F02F838E040D2FB45293413A38D02804D110352272EA55E0FDEC2F6C0215DD3BA70C0D19CFDE06BDEFD430D475245D2683C121CF3EF9F4BEF5A5C47CDFB9DC65BDF7AAD1C2A8B319F835F2D84641D4A2C4C2F0F9FFE87FF5FFA66ECFE4FEEFCFB65EBAEAC0B258972491A789BE570D1BC7E78DCFF040080E0755A5851533885424BED779CDDF1E68973A9CB8EB32B005A2B2577DBBD4163F80CFC33D14F9FEADBD9FA9C51FF52B656CABFFD7CFE17216A8B7B1BFEB7C47C7B84B22D93CFCDA983F255ED15A4E2B4365BE7DA979746DAB5FBEEE6B6BFD80DFF91F59D700206D7306083B4BD07E053BFC115585D2B30316E5C687BA7EDB591CBACD2661107C5514603299035740E46E51DEABBECD71E87E5C93ECFE6B7098F0D17EC1F6FF3A15B3377659EF2FF16B8EF6605B78CD6482997284899D67972E9D69A6B316178CB10A46D3BBFB31B7F27573F61EB9055595E23D30F5FEF8FB29F06F02F88BDF636F1A71FD7F95E078943AC5465996B0CB2B5A28638E548A93828233FA58BDFD4C9E527C933DDE7AEE709DFD7F87F3EFEA7D2E004CE0DEE27B2FFB03E0047CA12E5402F0569961D95456D37E8A40132494EA45396BFAF1B7E89F4584B8D956EF203564DD3F673B6027CD6471E893DC7168EB6D942CAA7968162B9C78AB08488089E5A762D7ED21ECD305BF539AE677714CCAD1FEB219FD9F6A63932ECC2B726BF19FA67F010B04212B248AE23DBBB9C34688D714E5251F0DFDDDCF2B3CBF034B290537D0CEDEE969D0BF0D92FAED7E4E5157BB6CDB2492831166EDCB50C7AC17F36630175F44B7FF633A04A0AC48E78C93A4ADD6C5E0F5EB5165FD117A5A2FA324F177184A8A84A9A7E8F86ECC40C02A1C8C756D05FEDC6EFE0EAB7321873442A508707EB008E5BE5F3E354C9B85C727146B3ED8E6CF5F0BFC0858176522BF73A04906644F2B28466BD086D874FD9FBD2FEF26ECA329B129E32911E01E2E4E61DB7E36917BC0C062CDD8D5BBF8FDBFF5247360923D190A29E0738F6B7BEFDD782597DB3AF51AA8E54DD544F2C0702C2921375958564A2680F01021D9E64D6145A914A4521CD66C518CA4002516D539393A2B9DADB5B96B2DE3EB24947B67E88EBFF84CD5175029250F5CD9B2F3D7F7FFAF1005376F44EC1BB9EF3AFACF1DE423F3082820080845FD410D52C46149154607C6C62AE25CAA738256B85C29554E36A346EE914517E3DCDD5CDA6764B6B6BD203ABD895FE0F6BB6F8940796F2F4FC379F7FB80BD0773C000040DFF4C2E914BAB364CF03484228B4C226B8C810E1A05A56B6B50B8C40127057CAD801D76425599A38601CA9AA64DCB679C0D1931D9E369E3136A658BD443922D9781E836233554C3796D7019CF4CD0F6476E28E1140E8904EC59823288CA93DE1A20055A7D545DC92916A8C88B9165C50522D4189E0C67CB37A437D2F9B9B8C5D634BEB28AFE5BAAAEDACD76D5B8CA04D33D75C80935FF3BFDC84B3B3860B2E30258D621C824BC264A92008CFA22AA57A45061868A2260E7C2888A9F67099913952B7CD3DE6965152A912A4E086B9F48EB76E7CB8E13A5B014EF30C9FE7B4DEEEC67EE764F54625D9C80EB009348498AAE0A21655C3D8A09A7500846CDC212A494ADFB5F7868DD612B75576D42DD30558E81193ADA2E66EBAE79F4FEF64014E1790FFDECB7F14EE69B65EF2D93C2745E38C900064A443B2AC8F58102373AD0B1E81600D014FB4F7D0136ACB1C293DA2AD46A6C405D73BD4FBA4FD2C5CDBBBBCF712E00A7CBB7F18BEEB2FAD36BCDCD40AAB953312E8854E30A1CAF6A9F78C8D206A8DA0514EE02C0CDA44D9681DF3335C58F2BE537F76F5E8B9F5DE594007C015FA72AE81CD677EE74E1EB4F985257BA458634CD80603C8AA749332D632DA58DBD61AB1951F9A547FE8DCF63C1603CE027E005CC956ED3F746676F2FE4E7DD048CD4CBE8E716B6ABAF35677BB9BD638F98AAD1A8C1FCCD1FE329EB5F7AC13013B00B500270C', 'a7edbb25a79ac7df', datetime('now', '-2 years')),
+    ('maxblagun', 'image/webp', X'52494646B6180000574542505650384CA91800002F3FC00F104D3068DB4892269D7BD3E38FF83844F43FE7079DAB4BED54AD2262FDB00534E9A2493A4B92CE4240926289DC4692A44879CC8CFE9B77323E080CDBB68D44F46FEFF69FEFA6F88343FF034862C01B008484DC8018B7EEE4467426400A01EF70FF3B79012439C2DBFA3383B6C8BD23D1E4E41E41CD8E1D72FA9027407FD4831C004CD7B6AD8D24795FCFFB8164C9101C899150950CCDCCCCDCBDED1EE65932CF76F6CCCCBC62E69962CECCAA8A8488C80807D9615BF4C1FB9E939CE949B26DD99224495A7B9FFBFEA74F42C495AA5AD9B31158D77AD6B339DB186C0ED6AACDA4E482E8FF77CFD9BE2549B22449B22D2216338FCCBECCFDF63A3F30FFFF29F336EFF35AD770371596030800013717FD166BAADBD8C9566BB56DDBED64DBB6AD359BB66452ED2D76EA57E4B66D23C140E76D7F011002F0A07ECBDFFE9A48FD1A4279324152B99B22FD49E06A241D77A4A901D9CEB41DA2416FDFA7B3EF086BE8F25502A80778A47ECF3FFC56D8DC7606EC6868B3C45708A53865DF57EB4DA3DB9203DA4241B2244AEE48FA6209E30653A73A6D8BF3ED7D40E5A36AF9EBBFF9AFC8D5EFBF2C597D7D2F58FF3B32C20B140524AED68F5AFCF592FEFC52FF0EFE5C07FD0885FA229F4A5ABDC725DF410F8D776DDDD7F65E5B6867B7B6B878DDBCDE9701ED8FA0F4977FF51F31E5F7EF755558B3E9C6AE7D3E76FEB6F4EDD003BBABB5C37F1DEE7FE00B15B1788FC5F34B46B5A85CC81553AA8E245DEBA957675D7E8EED4F451FAB734476B139D19C9EDEBF9D04B43C644920E4E7EAC73CDF7B28B18999E540F1047F5B564B8DF844BD6BBDD68B37F4AF1FDB8676A4C725F59A2B4D55609739C7545F8ECCF1465F0E5CC3B9B6AE97973FE1FAC752869A524EBDC2ADBFFA6FF74D40F061F2CBFCEC99EAB93F0E030BA954955ABB15032105AE621B9417F0823B1EF688BAB1BE31BD8EB71C91AF6A172085AE38E0BC94EFAABF8E13136F7D8E4CE58DE5175DFE34481FA66E24D83EE5F1F59A01F8FEE0F9380C2CE0791D921AC149396B3698661D38F046A35360960EB8501F35BED6D87A82659E6AF6283D32BD28E50AB0E412748D52D5FF15D3BFEA3DD74BD7B2AECD43975C8E23451A915B170E2600CA1F340BE76781ABBDB0E02BF0756E440C4707ADC78FCA52E1581BC7511C2B03DA61A6AA82F7A127BAE76DE0F5FAD35155E92900EFC597902BF8A731BE55D01AFD5AEABFE2838AC76EC1A0D8EBC55DE3464A91D014BF9D7739E0FA0395FE04C23E458ECFD65CEBF90A58744031A53199D5BBBCED06C3826FA459C5D432352BB567A519032974CDE9E5D0449CA0A841618A7B49553C42AE23F288EB927F7934B7C7DF1FB194CB1BA5F635CE2AF64FA4848A38F0E706F86CD657FA13F6207955796E32EB338D0E11A71A9406F3CFF5D2E7B7FE3E946A92DFC9E3762C3FE8A93DE99030423D94062655EBB234D1A892541D3442ACC021B9442C4823EA68FFF4A0E7C75F1E43253E6CB51D4635E395EB31F663DBC7B84ABBFD1E8057E0482DC7D8804082822250DA5AF537EC37B1FAA3B847BB16A5967CDBDED52B586B33B089132AEBD863DCE4583166124484253C213CA959A5B354875F680BB33CFE297AAAE3C3252F2934EB42E99AB18D202497F974DFFC0C59409EF5083402A1041994525AEA4C504695550491C1E7D861D47E4B794C8EDBDCB1AA81F320AA5DA2D46E23B45CC69B182DA2B9547145BD60A784A091F888AA453ED672FC79E2B509A4201834936A4F74180BD6829F05F7C9F76F06F6F082496829B590122AD6A8A792E3D15A313ACB661C125AF590AA8916F3A257A186142C47E6B00A3961CE7500A444777482988F8CB07AABC690800213F8026BD58E9954B6FFE4B396533B1101280E294E3AE60D0717E6F51E78CF7C27F4ECACEB794B0928A00656204936E47CAC5FBE6D3CFF8A1FBF01A322CA443763F1AA45578775785E16721107A67A5B48C5AC891F2815D4AE624DCB64CD27D69F6B7059AAA52356C45AA68A2E22CB4AF94FA373599B4B2BA9308B404F71DC653A8198EBF9BEE3B79EF75AED1DE67E1792B4A45304B2C850E343DEB575AC428780244888DC3147742792BEA4E798DB976C2E2CE948588DABA8B57AFD28EAB1435D7B68F9319641BC3EE2F39AAC0BBC548B5DB6C04FB2C7D2291E65AF655E1EC96DB903CBC4809B05A9F12EF076487AFBFDE7FD3FECBF85DCCD0FC33C9B1DA8244D2985419002E2A9788CF21CD97989153AD5B20E4C9815C31DE6445D914EA841582141E3AA8FF2C2D82B06412808BF518289D15B551D02A1470868412E322A76A483C5BA9CE0F2F6FDC397CF974FDFB5435C0EAAA97DD451AA83037FB87E9B77577E06627E10B66735128E8072056B38D676AD58815ED3687DD4E908471DEDCB222F7B4244BE542242A0E242516BEC15A90450947329D7515E4173B1B3FC43BE2E3EA5BB4243DE2A7FC40C9723671D604C6F20AAA686BDD6A8153D5E1C04EB183ACF38AFD153BC73E690FAF83305D0720713B28E587B79E85C7A1ED6D1D198464D84920A4689AFB98EA235EB58245C4A21288AA5289014B5EA55C3550D4BA74CC04023ABC85CA12EFEBC54396CD7D1471C3E2F87BFD7FE37D9898A1A4497DE8E1BAAF563952AAA4A97BA7A84CBCAAE87F9BCFEF3E95BD82246B2D5F1EC02ECBC9D9F85C81FD83B5FE9B89607F53A98DB8247C69A0B1247ADC515B2A1CECB12D1505A2F5B7C110E5712965249E05D6E55558892048AAAA95035474C499B5AE509539777FC7C1CFF8AFEACABAC95AA8DCBDABE1F238DD92F8FE409B643679100C336CC8AA6C0EB63F6E5434D90E0BBE4F9F735AFFC7D7A01DA6FD1234BB21BC244BF4B65790F23B1D026A6889B1CCA212E1A0C1C1715DB7E196991C491461888100D51399577A81419B5562338DFA6735881F76D68FDFFA1275E545E1FF659C90DC951C3FFD5D6DBE87C122D5056A850592ACB8CB457B5AA7A011E52835482609E9FD843FFCE12C0B15BA41717406B4A8FF0C0B62ECE8F707B9815C31F31DF004129625889B29A54D4050ADD3240AC68218815AE50B188F2C00B10E61F516F9479C91F609579BA3DFEA9F257BCB4150AEE22A92597D25DACC33C5D4C16BC885292DDD22BC8955E0EDC07F645EB2017DD43ADD7825BF92DEEC25FF74A523EA2C2EB393AB7A3F88056D43E8E8581A2A406BA9E5FDA4D9DBABD2CD630BBC801C9A718412B6A155ABB839B8FB5F5907B447987D738388AD18C6BD1EBD8ADD6BE0C9E8FE5FFF81725E101296C0580F230969912830CC522A4808C20A82AD507771000D034A3795C85804A00593303EF074DECCE9A31263E86599CD5BCE97FCE97EB5A9A0220EB89A5ADC19CEACD8AD4924660353A8C3E44281696EF78EEE7DAF87A2B1AB6575B313994B0DEF8496FFFACFE7C79E98FD5DC8BF3455749890465E802006FE05ED84307CA0B82B2B42041A83067F165C85DA6A11A26C5E30CC0468004465382B76A8D461FB3C7ADFA03CFFEFEF2442ED7E65035B4852C48364B25AE4CCD0EDA87D6B556474D3FBAFBD2D2B1FCD0A55F31BE87A800889A52CD2676EAB2FF16B2572FD8D2DACC6DFA2BCC21520190A11730F2EFF2DF603B4C161B60F18A3063388F6A2EDD7DE40636C280115D1116A30139BFC1DF127F97DF9273D0023D9471E93E8E2BA3FF4C942E53505E14000821929E38775E56EFDAA07EF3B7DAEA0FC720F4D73ED6661439C495CFCAA65A91ADA18232322D836CE93023CEADE89109285081526A0984DC25DF831F202A22655041BD2FBC437E8EF01E11D135E816722B752C9F64BD75BE1367EA3DC49FB781B4418D501E6C658DFB20CAEE83AD8000004019E28CC6B871C3483926A0CE10219B4A2BBEBC71EA72832208AEE48C42EAEAD085E015B43C258CDED7EAAD2F756E504000612C9B876AA90EB587EC45481590858ED81AFF81E2DBAD792850AECAD78A2EB02145CD90DFEB3F3357099975A42E6FF13A6774FC30844B8098997594CB1A0B53D8C88C3D1D3706896B42014DA5522A05BD89636FE30D5765CFAB66D00606E2727EA1F536F58AA28A81782DF6C3D74F658352686022C9C2425ACA16C25BF9469CA567F1406ADC7C54F386C98FB59B1AD66AEA111121B6C66AE6DFB1A917B3DE84D2C4004D7C582AA5B28DC7FC727AF4C5D42F47EC42504B1FD397A7E7F152BECDAE6100AD0147157406ADADE4A2339F30FF69A9179935EC35E63F3F4EFFE5625F0FD72055CCB179BDF5E70B0509918FA636BA1E3A4345C9401A3C88A99A5580D06B33E576D553BE2B48520A05B1143529525B901290A026070B0E345EA930AEAA523EAA5B14A859BCBEC8ACE1D48919C8A53B6BB6B0D25053A1C64C0D5D63FEC7D8A7DBD25F8D777DA9CFBDE84B4FA2A72A2E554D0911059F91FEFFEDDAED4B1665B9E48CD6BEA43394837A2108BDC6C00791E515158541A462E0A3E81556F0021B9430D7A4A44D420F1C45BB42032A49BA2A55860A6A0D0C110CF6D0B304ADA86C23A7B6885CABB0201CF8F2780B32237258C1AAF455D342A7AB5ECB4790B49673612AF98E43FF8F3617DBA5B62840CA6AD519F5AC8623A462165B2A2B122A0B801532E0202AA2044A82649290D7444AC6637E6995F2A0332F59E4519D2A3304A8607A2A411DA241AE149F953C4767F4B6557239DA372C1C8F9EC78DCFC7EEF3C78D2BB61CCCE22A36545776A231A40B20B0FA7EBCF6F5EDF4FD60AA2126F3288192FC3EC2AC508A062895E53D40C1C365C920464C8896687109006D6437440E841E80445242B519DD204801BD65150F4E19E9604163A83A765BE94B1CF893AABBBE08169F0EECA33C14B406C79B4BCC3E3EDC7E6CFAD8BA1CC53AB820B354565D8AADBD8BFC0D37E6F03FDF8E8F82DFF8100EE8425444070F0080D82501585AC8F8696EFE51BEFF02AD28890403A01922DB89228F1FF50F040CD04003A41422600563A589554312D2EB8D5B8B85AFFD52C30D3BAA1F03C7433552D0BF1C7ED60C2F734F31EA58393F362B366FCBAF628106A531EC908AB8EAB9A9B99FD17E1BCD35E24840035C5DEC0611B1801E9483B965733BF1F6DFA6DE6BF75637EA87CABF90436A0E44D12353DACB28804CD22A1D280A8000058AD228E5B26B543AB2AD853BE62B02905140F9FD32BA7D24B55EBB7C1097F6FCD5E8721B711B9DC3E872BCDE7A77103212A5865D45068E32D44AE96C46EFFF2F2B1FD19B98FB45D78E3558C0923D210605202865F70F335A5A4BFACB1E51F94D282A90E0A03DEB6D6F1C1E2494A114006010010141504D0B651F95E1B9247BF5F60A0A4102A6EE614E44EAFA01777EC9D64B6B7D691DB7E9FAD85F1F0079C30CD46040AC9E6ABBF421EDE1C0E5A5A58963BFD49B82E367D4D6DF16065A6354B52A205A4A085D56DF1CA349A813E9BC2881042EC4F3D39E280D6ED64FD617918462C052449C0A120195CA147AAF6E7430B1A11B9728E8503DC533FD125FDF4E5F3FA6723B74ACA939EAF9D07C4C5B9E687CF34253E8ABAE6E2467EC37E22AA983A3B08F24F19144A9611557E79218F9FC74549A7255D4F383D1D4161F67D7E535ADD5EBF1B6EF9795B72F138B9FA40DCF349CD0BC6F06633046922210A912B941A434CB470BC287734B837ED52B859BF918D738DAF1E1C17F56FD8D2EDF38DE1E07827C45CE8244BEB06FEC353EEADA81EB7B35A71E5FB052E872234C49AA41971D7B88A84EE2BF4732D19666E6E54DE89D8974BDFC07DFF28BEC719C7DA0F3E3A5FFFEE5D21CEBD787998794EF0FB1EF00C6C2383598682495479352412D1423DB7ADBC236FA1FBDD52CA20605EFB130F52F55AF5EE0729CBCE1EDAF5F16AD74A4D705BE7955ACDA4ABD7EBD5DFD561BEFE3B03015994495E1BB44F2BE586A45000580A88DDAAABE4612988C4BD2DB5267899190B3BA897ED6D2447E8E1DAFFE9328E7DD94EF1A0D46B3FC6082D83279042106BA2B751C50FD152B6A2DB1BAA980B43533D1F2682A40A8894BEF63F59FFFC7E18FA373193B07F63800461C1B67ACBFA1DC5FBA4F63712AED48B68A37482F56C8405B9E43331480544804980FAFA1127BFAF80FC60F3036D7874B08826AD1583AB0545A713DC1A94999925608DEA0561A75D45D30204136806A91FA0FB09EB59FC372500B20A63AD2E2C46E95A4C04A84EEBFFEAFFFF8A7FF37FEB062ADFAAC62AFF1567C933975D41F675B537D4B1A666B7807D74DAB4030378004180E80C00514479E72E800978F385CE11F13B41F2200A52E35ABEBD857092E3FFE2805D477E654DFAC5AE5E8D7316255829344CA24F6E5BFF598F8A1A6D002002041457BC011EC08A52E2345FDF75BB5FEF0B6D79549C33C70C179A2DDE8827CC310526BDC637C86D6622D9943E6702C4928C280944AA4491E9778B4A4C31A06A881B2500A518C5CB063658AB74EAA01005A9AE209184B3B595BC1CD68D2E88263D6F37AACABD44053D145056D4DB552459340964C01B4851922CE1AAB3E76D1674A43AFBDE38020A5A296EE651DE3A763F5543805051B003255A90200D00844EA217A8CBDD8A5472A20202802952A852D22D7914447876200807CE63E8C93E048221F2D46E7C0316360BDC843789003960819D133A6FDD28A6A55A5C1C84416EC01F6D25AAF720C049B511E2BE8702E35CA82B4D740D87A802A00D80090596E96666960588C2C9756469B458644AAA152314552A91801E6B19F55A77A701F00005049A2F60471B1F416E9048A8E1A3D178D05004D508091A1A6C13E10C05E96EC40CA581A391DE223B43606AF5031C58DD00807922ECBA85C5B41882000008088A00C228884A2986A4929A1126A00492250315546BB92E76316E40A05B5806E000000D95C9FB216C060E9DA1A957165B43C0F01C4CD004790102437D1F7F1BA5459002AB2AD089A2A4C88C5C4FFB03E2B754A13E4D7A12DA4D6AEB8D1C3F9A20350240144D000CD2264886C90259012050D80842010511128DEE071D450A3BDD5EB00B7A4C3CB4968AE336E46DBAC358DCBA9110B20A5020080E0C0C0DA895C0A1300B06C05A390E2420C2CABF47AAED61B85622DAFD51E05EB8554FF11F9805431832C9B0285289908AC44CA00452A051553022260164BA40F1027281D8C497373E993DB00DAAF9BD3CF1446D28675ADD6324729D848B3C21C2A5053A09087040098808BAC44204AB26A038085AE0E5AAB5F07959851CF787A62F59409B4110D34D86180169C4B71694B46800348000B600144C48173C48C33AA74C64E86564F03DCE1CA7A9FFCB7E7531F5CC3A5F5E3987084A8074A42BB58220D00E806422236484802892A00A306001290F1FA8FD156B1AB224AAB7E2C65A802113AD0060011815572F4A4840082C0C8A3203112001023B14EAB90314460EBF3EFEF7B0077FA5B303E9B26BE625CF3B782D55378C10020092450839604614CF9DC8C8E1E011050040200309080053600EABD5441052168669908405881E522E7055532A029A028308581A73C1209291DD9843F6240E99473B702DCE57F4248DA2CDCD2BAE137B6BA541529CA136C90A42980181402583CA3ED02F2283A48008001A00600B76AA6D11895904E3111521A641ABDC0B00408C54080084C314748452A3512C9B5FC808C40CBFFA2FFFEE16E0066A107D719B0C3479202314B040A01000080008E92B980058001599607B902A5065400A082001260C0301B352A3D1214354438182D31B404002152CC8AC0531E84C813062855345CE0B452A8CB611DC0BDECF2670F978D710A9BB0546401000068A4539A30569503C046C89228582400DCF2F638B35719545D098B8D1698052A43400482448A94030DA8550C4C222A64108D08A8F99D906900F736F4A47AAA2534012349A8118008C1E106550E89342009040904040000000800B835C9F4AA5855A0892929432A0B81440C10088A88031745150C159040024BC106EC6239C0FD74ED8948D5820CD800D02C80C86203208C000044C214970275BB44C22D5430C992687F46774A800808389088071C04C1A3900B478DC051B2A1068D506BB200EEEB072A9B6BF9DA0B006A8A2400A13C9211190000000C6020C0E08E36B16000C0B0C64123038244F0961841F082135C14281DF844D5E2C01B644C0534DB2FFD97FF0BBB3F807FF33C136A38C116490092700A1901202281245169C081500000B74A94D2FB00003A923AA2B216535188424012442A0C5CE000221579E0A298F251345ACCA73B4AF72F949C6A4A7F4B0A42044C01DC12230C00B48B0D00000A00D8110A3DEAA46321A1A60830628014831030CB493132410864904B008594A5BF6DB31CE041357CDE09B60020508829254000008001A00546929004007601EC5B71C6A31370102D2F3810418CDCA0022E20810D760850030641CAA0AEA6D3001EDC313F7E48FC40120022060A01900B835B0048C2AF6829CE82A5910E18C4C05B918A81CBF2A86E0C403DB01104B160498A2203E5A2671DC0C3B80B3F285C23B6FED63C0882624B26B480294600878573C20C0062C31B7E164F71CA819A630246A090EA44B62B03004CE86EBD72B7FED1E3FA00F0700282FBFFFE2B45D35D04CD7001DD9A082B4E741D170A8F05498B052622008210523EAB1446054A311809BB0E19988816CD8A9CEF9C7FBC007868012D809D7EDFBDCCDA4DC05A020CC263252BCEACF526D57C2092A0684449248008022A59930107F9482B3CD88E26A23636FBDC178E015A001E858076C0314026C722BA71FCC23FDE551F82E069A00127184524096445E054755699F02C3D502984206C15AD1E1FD33980638076804726A012B01190D3BE5E49AA6712C20EB8F76359E1ED574A1AF5F05BC5DF2A3646F135782A52B86F931D944EB68F8C046C7CD05F3F0000', '84e70fb541135dfe', datetime('now', '-6 months')),
+    ('ramsesmiron', 'image/webp', X'524946467E120000574542505650384C721200002F3FC00F104D286CDBB64D42CE42E13AA2FF416168CA0FD25D67A75EEBCE1112BABC91CE49AFBAC0F069D9140B259124395D8F227AFF220F7D16C234B2AD5667506F509470FA2F09053F2715B51BD5B65D65FD9CC3FC47F6B112EB74F995E122FD0F00ADDFF43BF5C189031060ABB5DA90F9CF6A7602FBC5BEE7DFE6DF5A43D2F5AE805F99BE1A1983140CA4D6BA10110132D85D0C28F9446817ED34FC2D3152186EB8020802DF3300184341DB364CCD1FF62E848898802C61C84F5090AD1FDBB61649D2B66DADCB1C6E66666666D46FA9B46CDF2D3133DF7DB8A55B66E63B31C06C0BE641D9826D9E23DB566DDBB62D4FB58D3E172F2968F3B41D5EC14D122C813729C0A405EF3DA065DF916CABB66DDB9647A96DE05CA8022F5996DCA4C4B2AEC2FA9BD47BAD490EB4FD8BA33CA79DDF6D894370A74E47AB95BBBB43E90E11DC2953626D3AA44C6533826FC7662699D9EE2C1340BBB66DD58DB4CFB9F0F889CD50CDFC552174029D4107D901D4E82F666632A3A4277A70EF3D69C89E23DB566DDBB6AD9473A9B5F53670B1FE122D05D6DA105D21C6DE6AAB25FB1620499224499200114954E38EFCFFCFBCD3CC940823A0A3B7FDF55FFC37383B25A7FFDF8BF3D4FCE548A467945B9FD53724D72BF6169306BB6AD3CB5DBDF8E453F9385F20024FF5977EFA7D35C7566E6BD3BD24E774803A72C000065D148080C4A0CD2A719E3CD7C941E169F9DE37EBBBFFE9D783ECDA9B2C0218C06E740139298261D08128481939513CC1E054D4BBE76EAF207E0ABEF3B306FF7FBE9F9EF6EBC9D410BD0076DB721D090CD889916691312EBBB0D14117EFE40A50080748A19A8BE7D1F33EFC9ABDABD071F6FC75E3C5BBAF77D91DD0019A466387B02C3B16F39B8E3F8DD9F1AF1EAF8ECF8FA9597A51DC414911081020D1B1A2B37FBC6F7CE69B7F74DCC937BEFF366D7E7AEDC302C06E45A6382BFFEEB1DF27F7CB6FF7F84B9C8F3FBB3CDE2C7B209EE3138FDB67F1D262993BBC937358F04058B674081082D4BE6FFCF26DDA1D9C7F645A6C9E00C52426F3B7F193F2CF8573397F99F331EE2CD7F1AC973CEBF938EF319F63DC39FB709EFF94B78F0F9FBC59F637B90E16C020D803C1010C4DD6BC5C9B766BFBFFBCCFD70603D02628ECF1C5DD7EF79AFD66C17863B759823D1E9F07F771DC1C1F3CF79A679F395C63198F793BDEB5E3397B19C849CC22068360C14428E3DA202F9B7F3B5FFF56D7D9DFDEEEBBE74CDB528A9C965C691663AFF48AE16E90FC9C82CFD164FF68761E9D0A534138D609410159486449E2343149C19228802D461160717A694BF65FACEB16BEFEADAEF3BFFEEF7D581780360694E842F9557695763E5E15CBA3F1D4DBE7E7E01D9E3B7DD562AC0311C0442121784952911E6B1EA555A4C240CD1C12044A375064B9CCB9194C9EFDEDED3EAC130168CCF6547067686D9CCF73AD64BDEFBEF3D267DB97CF72CA730060D1473442D1C71239E00242D03AB19B4A653D0483F66C16A04804D466DCFD84C99B1CBC97DC7306AC1D6E222B13377A6ADA0EAFE6C4A75FFDBAF1FBFF17B3CE0403D00DE760931010AA801991911344161073823BF94E047C5C8E8062401B0753BA0197AAC718381924C6D6C6E2D506B9FEA4470F3EBF661F1B7C44C58800A773C0A420684A98800C900E41F18254E0208BDC966FE47D09B44801628C9E311E9FAAD7F2ED53C86A81B62226C06387B2548D896A420D97C61FAC5EAEAB3242562CCA31655905921911428E654884D0751239520E5894E0005AA5A02F420088D250ECD1C5A7966F77788D7BFE14421DB40D009B1450AD36C2D8D81E3C767DD6B90200E648523A9B6A4CBB4126E549B42C6940ECB0D391DA626760075910A35DE9D51D7310148428260E3AC6AEFA3D577805B4A79EB7C6065A11D0C11EE009942AFBB33179293A8F5925B1AA38121EAFAE67F90FCBF5ACF3EAF6229022AC19EE9F3F3BEFE4EF47F38058078525D0D6D3D3EC712F92B9104080B6743038EF6FA17DEAE8D3071381D3311B720EA79AF1E8ECEEDBFFDCF86FB2A55A9EF772ED15EF1BA651D478625DEC4797D299F4183B5E9BC1455E7F3EB9FBBB963C79FB6682669423FEE3DD1F9A094241880D140188C5233A7ED88713D09A3BCF1257E8A04CF1A7973C3C60FB35478FAFFAFAA37EEA21EF5B7E590DDBF33D518738B619C51B8D6CD0E53E4F59C61BFCF06CFCFACF9FFDC59FFFE8FECB77FBC31498BFF561399774800605AD0055C0EC3C83D6EA05FFF835A59AE06A64E00FAFB4356EF4AA78F1F73F1E97FD197E9DF26CEF91C9F55D3EA55AAA89B74D1C31DA286C36222B6C68CDB779FFF5577FF9EEC7BFFB8327CF5FEC4E16333736298400628551C4869ACF4B0AB410E7BC6E8A9E0840AB3C10CED2D2DF4B61975FA6FF9DF3B331A7FBEC26D9013B3F745D5A354632E73B9B52BA6678856F974A69E760FFF967DFFB2DBFFEFB6FBCA28BE7C260C31E0C0132BA66C1A40439F74D884121452A60C586A280626246C99B9B45D805449F199E9B14D8A59B02B2CEEAA09A5287DDC72FDDEE627DE350346EEAD468FC92F8F040F7A1F75F1CFFF56EF7EFCBB40FCB3928C01E38C6FD09583C180E850530300093A30229D0FD7FDB0910A10194AD010634E3600ABF9B191A918086906DD4BE749FB1E70C5F8E2FF5FF402F8AD1A89CB64D321D8D9CF8D2E6CAD998B7FDF093177D74A10393D2083ED007BA09806901C1029A82ECDF5E3B4169690C5201DA2A3A9062F68793B64822605137DE1497947E485EC99B35F19F3B5CFAE5FEE7EDC7FBD5CFDF0EA6F27F85EDE979D1CAE85F9BEBFB4EFE85CC8D22D201100CCD400BDA6E6C125B0AC83969280054C4808A5601ADC286C219F358FB19DDB4CF4683B2D1225DBFB896FA47BD7F37190CD7E5EFBF52E7E02F85E934288F0B7CF5A073767FFF977CEF7F2FD3192040CBC645B445008608104B04A0229CFBFA8FFF15684BC0C900B40145685EDC0CDE7E7DEFEB493F7F33E7337AD84279CB5C520EE996E5EAD62BDF7964E4711E79A5C0AD3FE1F6EF95FC8BD76B1FFBBCEB1D0EEF38404B5130A045080CC3810480A12DD99F9F82339F92A1631514B41580213EF3530EDFAC577936AE1A572BD96EEF70E60822EA65B2C41575C9565389C57322FD945B3C2FF18EEB5D1603B4656C20D8100C01BAD1B444E8C8DBA7E4FEBF16232B014E1343EED91F3549BA37D888A068CAA81156B204DD60932BF41EDF0FF6A2FDBFDCDFBEFA6C2F1EDE6574793E9C9D7111A52805EB342C360001EBACC5F363F3C13A10A081C0209DC4894BFBF55107F7ABA828993473A8BA439C44C4F8C1193D0B877768533DA40ACE999DB42748E7CB8521400565C3440CB026D89C08F3A9C409015A018A0810C997FE598EF73C540B964C8DC715BAF7154AAA08AEF4F9E79FB9DAF3C74F9F33B2FEEAD57467BFCCDE7B476A2D5DEE32EE80060CD036D01011411383000488460919C5AC02B04604681A937D6EAA4E4338170F19BB138F72A4B222A8BA9487C946BF2AA0E9EFD774F223D1ECD227D2EEED8B834B509002CAA061C10004D4B1010C08808C2ED5D78001DA60B516E520D3C63FEC0E0E216B2A94AD1B521425C2CD24DE1D9AADDEC761DFEE837A372D5099B59ACA735D0E9B0094D19852402B6890827540FADCD30734602DAE400E4A197E568B938608F23C4449728ADE5BD8A9EFF6BADB27B97232C549AAFBE779242787D6FB5EFC8A02DA621800213420C50604196C30A00CB73E1AD01450073082AD409302204BE88BF7DFFF2BF90CCFFF01ADD79BCA241B951056FF8DA753E9706C56EDA28BC3E9F46FBFA5779098D90880C919200A3082423830A04D20A05B80130344004C1D344860800846A18048A2570A6387C0DCFA39A452AA24347FAF3CE005EEFA06F765DB4B797E5375926356EB5E9EBB3F4383B820A04528348C4D50886E601D27768B415B164A02012D523C144810DE2A334752034A4DE697F383488452255561FCE4BDC5DECE4BB77A1E6FFFBB703EFBD2D3ED8310626018455463A32080E846E30098EC11C3C2FDC4AC4600DA94C025015A23D24240DAB238629C14E478AA5B3F1D2637D88A8B2AE37922779DC5397C3CC9D6F8E5FF6E143BEFB50508224D049884416036E1B04152902AA5E1D4C8A926405B24C5000D900A0C72941215D49734ED7C697D59C95B8EB82E4BBA580CDF3DF27F3C8BEA7B3B359F939AE7A9C101102D05C18016A1B1000C109A5204D06AD3AD8A8056B42000459482A7AD4821F49818035AA988440455392A95D4776B5E9BD5D9F272134DA7C3C7BA77922B0934A8A64864405808508502629691810418A95E3C2963C03A3040CB4052140A004A458D711187D8219D8D5AC05C356563C572922D1E4A0FA20995D5EEF3CE323810A0480014508A70A1015B9A1860280220DEA77CF47C913668002A401B18118103B0050268545AAC13C92901CBEE72CB4B664821EB585F26A8A2D3881CA76BC4200042D9084A094740B36D6380342E3A080C90B6EF7EFE0251540215E04AC4450082468C788ECB2E0E6E7ED0A94DE8F6ABBA1FC7B5B541F59ED084C5FA9A4DFCFA899104005B420494820290D22080A00897883B0804D4B04A1001BAF35D07A045808B004D8884E650A6D85D0F156BDC80B39B7879BD36CB4DD4EC5777B1BA23BAF15B37A32FB505A06C8140B7222115506890AD18470414B3B54B270FCAFDCA8120A660AD20A28040403B35534C60A45A3CE4A495916D51440A37613E65EDE147ACF5ED96146F112800A400510490425630018D0313440846007074D7470E140A6915E04200515084E246492140C281D8CD1E74E19C48022AD643DE18615E143FE8D757FA180DB4CBD15763BC7194012900F216084069BAE50CE1B209CC22C282D82A5000ED70BD13094650D0D60D412104C3008DD9F5D85F0F01D22325520587B219D27BA343BA62686471CA9AC8FC3298D655165112620B2005054DE0500608C6EC966E0981D17907DA3EF77FBD6235BB1655880870300CD0E0E2647ACCBE076DA310BC2A77908055AD1C0D0162CBBA146BB056CE16837AEAAE689EA67F8C8D50701183D0B86C020F0B663165359FE57EB58278A947275A04B402048426F1B8DBC1ECDC42991280B876A3252D12C57409B44E39BA62C00A834A31CC1AEDE6F71CFE5FFD9F915804BB28203D12180706472466C1A53D8278D5E71DD9D1A2155280B42230C0EEF59BBFDDF9CF99434C6754E20C04CB4F3ACDEF6185800DD378AFB18D631A230F50D63AFD793EFCDE1FFCF09D5E27B6B0741380602815C361175C247ADE47FFCF82D367CFEF1B975B008A69F100481C010144B473F7533F66EB733487BD01FBAD25CFEC32A4643A292915B1AA630E92B2ED70E40D8A6FC7EB9E3F7BFFCD5196251DC4A30FDFFDE39AB36BC6890513C738009B010DE7B904AE7CC63F3A66671F688B60F00094C58538343BBFF9AB5FFAC9EBA5074CEB8E442A6C3A0B229211894E345452AE2E0B90A036DF33D8BCE595579B386697D1D86419870BFCF7E2FF8FE3F2CC38C161031460CFF77BDFFD5BFDAA7EA3B769B179A0195BB3C9C023462F977F3AFBFBE6D147B47A7C92A3F54E9329B2755E9D5624951ADCEA213B1D8DA1C03BCE293791CAE0322F1430CE88C585D088F3EBF2DFC3FD4796A703876043FE8FE334B8EEF93BD36C831EB68C76E32D0729FFA85907CAB0B2BF336747A7E995B237AA89E444F2096DE23B23CF71E9440C20064D5D231245001B4259967499CE60D285F2B8CBFD471E5CC77231AE67CCABCDE0FAFBFFBFCFAF8706C8A13C778FFD6ED2809210992482C67DB0FF3AA85FC040F7EA385F33FC97D1932258558044405D048944C462E0A12C2E0329A31B620474099E9CE37F4FB9EFC723E0A68B4F991471D83C771C96D18CEB111009B2D12739B2834C942C508050411AFB40A26BD56D129C658B8450028B271880680C80015B373A22F37965C08D9FF5FE9FDD47FBFC42BCA00F7B1FB278323D010A052951CA42915C4720090827E809A982278076015B12025B204F8647BA2078300C4CB06D74682C743E7FE4FD2F9B6E06EBBFF0931FBB779501E31AAF781D9E40462A40801C710096822C6900B24810254928604B4200B2A444C966105393C20E8C0DA8B105C0B3CC47DEDF1760F266ED369F4A236316E166DCD9841B87688C639819D342878E340C0F82D221D389983C0244A463C1584404CC12878CE9946012DCF2AE8F2AABC56019370E970A200610CC0E22201411B103498021962AE0B24C23B26000601673D1D59ABDAAE0F6F7AF1FA156672C05B0808A20824907C0B807404431C60816424428632C5D6274046C52B6509710DCE54709B3FB2D8A0831503100140D084087108D892044018D3B1AC120731966DE15C9DB8FFA2904770BEDE7F825A15D8566047429161716D021290219C658021453458048B0149CE6C4A39D5FB3D006770D2DB8F252BFCE18AF3B3FA888311C0181383463800044BA0503296C744C65CBAA8CEE77CFE315C4E069841852B078FFDA9ADD8B74AC38B4C485B22D1BD182C5043608C88D6A4F55C295B73B0705F0F44201CEC1B263EFC1FCF64ACE6797A797E6DB93C019A5F5D9D50798D8FA33D2C06A3B2E0F95B95E5F2002B70D', '4dbcaf1282c24ab3', datetime('now', '-1 year'));
+
+UPDATE user_ SET role = 'admin' WHERE username = 'juliusomo';
+UPDATE user_ SET role = 'moderator' WHERE username = 'ramsesmiron';
 
 INSERT INTO comment (parent_id, addressee, created_at, updated_at, author, content)
 VALUES
@@ -47,6 +370,11 @@ VALUES
     (5, 'ramsesmiron', datetime('now', '-5 days'), datetime('now', '-5 days'), 'juliusomo', 'i think he is saying he wants the standard "open file" popup'),
     (5, 'ramsesmiron', datetime('now', '-4 days'), datetime('now', '-4 days'), 'maxblagun', 'i need open file dialog box when a div is clicked. it must be as like alert which is not part of the web pag');
 
+INSERT INTO subscription (username, comment_id, created_at)
+SELECT author, COALESCE(parent_id, id), MIN(created_at)
+FROM comment
+GROUP BY author, COALESCE(parent_id, id);
+
 INSERT INTO like_ (author, comment_id, rate)
 VALUES
     ('maxblagun', 1, 1),
@@ -58,3 +386,21 @@ VALUES
     ('amyrobson', 7, 1),
     ('maxblagun', 7, 1),
     ('ramsesmiron', 7, 1);
+
+INSERT INTO karma_vote (voter, recipient, net)
+SELECT l.author, c.author, SUM(l.rate)
+FROM like_ l
+JOIN comment c ON l.comment_id = c.id
+GROUP BY l.author, c.author;
+
+-- NOTE: each voter counts for at most 5 points either way, the default KARMA_VOTER_CAP
+INSERT INTO karma (username, points)
+SELECT kv.recipient, SUM(MAX(-5, MIN(5, kv.net)))
+FROM karma_vote kv
+GROUP BY kv.recipient;
+
+INSERT INTO filter_rule (kind, action, value)
+VALUES
+    ('max_length', 'reject', '2000'),
+    ('banned_words', 'mask', 'idiot,stupid,moron'),
+    ('link_limit', 'moderate', '3');
@@ -0,0 +1,159 @@
+package attachment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/gabriel-vasile/mimetype"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// ThumbnailSize is the longest side of generated thumbnails
+	ThumbnailSize = 320
+	// MaxPerComment is how many attachments a single comment can have
+	MaxPerComment = 4
+	// maxPixels guards against images which are small files but huge bitmaps
+	maxPixels = 40_000_000
+)
+
+// allowedTypes are the accepted types, detected from the file content
+var allowedTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+}
+
+var ErrUnsupportedType = errors.New("attachment must be a png, jpeg, gif, webp, pdf or plain text file")
+
+type File struct {
+	ContentType string
+	Data        []byte
+	// Thumbnail is a png preview, only images have one
+	Thumbnail []byte
+	Width     *int
+	Height    *int
+}
+
+// URL is where the attachment is served
+func URL(id int) string {
+	return fmt.Sprintf("/api/v1/attachments/%d", id)
+}
+
+// ThumbnailURL is where the preview of the attachment is served, attachments without preview get an empty url
+func ThumbnailURL(id int, hasThumbnail bool) string {
+	if !hasThumbnail {
+		return ""
+	}
+
+	return fmt.Sprintf("/api/v1/attachments/%d/thumbnail", id)
+}
+
+// Process validates the type of an uploaded file by its content. Images are decoded and encoded again,
+// which drops EXIF and any other metadata, and get a thumbnail. Other files are kept as they are.
+func Process(data []byte) (*File, error) {
+	mtype := mimetype.Detect(data)
+
+	contentType := ""
+	for _, t := range allowedTypes {
+		if mtype.Is(t) {
+			contentType = t
+			break
+		}
+	}
+
+	switch contentType {
+	case "":
+		return nil, ErrUnsupportedType
+	case "application/pdf":
+		return &File{ContentType: contentType, Data: data}, nil
+	case "text/plain":
+		return &File{ContentType: "text/plain; charset=utf-8", Data: data}, nil
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("image dimensions are too large")
+	}
+
+	f := &File{
+		ContentType: contentType,
+		Width:       &cfg.Width,
+		Height:      &cfg.Height,
+	}
+
+	var (
+		src image.Image
+		buf bytes.Buffer
+	)
+	switch contentType {
+	case "image/gif":
+		// NOTE: gif has no exif, encoding all frames again keeps animations and drops extensions
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, ErrUnsupportedType
+		}
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			return nil, err
+		}
+		src = g.Image[0]
+	case "image/jpeg":
+		// NOTE: exif orientation is lost as well, images are stored as they were encoded
+		if src, err = jpeg.Decode(bytes.NewReader(data)); err != nil {
+			return nil, ErrUnsupportedType
+		}
+		if err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: 90}); err != nil {
+			return nil, err
+		}
+	default:
+		// NOTE: there is no webp encoder, so webp is stored as png
+		if src, _, err = image.Decode(bytes.NewReader(data)); err != nil {
+			return nil, ErrUnsupportedType
+		}
+		if err := png.Encode(&buf, src); err != nil {
+			return nil, err
+		}
+		f.ContentType = "image/png"
+	}
+	f.Data = buf.Bytes()
+
+	if f.Thumbnail, err = thumbnail(src); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func thumbnail(src image.Image) ([]byte, error) {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > ThumbnailSize || h > ThumbnailSize {
+		if w >= h {
+			w, h = ThumbnailSize, max(1, h*ThumbnailSize/w)
+		} else {
+			w, h = max(1, w*ThumbnailSize/h), ThumbnailSize
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
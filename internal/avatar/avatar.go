@@ -0,0 +1,110 @@
+package avatar
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// Size is the width and height of stored avatars
+	Size = 128
+	// MaxUploadSize is the largest file accepted as an avatar
+	MaxUploadSize = 2 << 20
+	// maxPixels guards against images which are small files but huge bitmaps
+	maxPixels = 25_000_000
+)
+
+var allowedTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+var ErrUnsupportedType = errors.New("avatar must be a png, jpeg, gif or webp image")
+
+// URL is where the avatar of the user is served. The etag is added to the query, so the url changes
+// together with the image and can be cached forever. Users without an avatar get an empty url.
+func URL(username string, etag *string) string {
+	if etag == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("/api/v1/users/%s/avatar?v=%s", url.PathEscape(username), url.QueryEscape(*etag))
+}
+
+// Process validates an uploaded image, crops it to a square and scales it down to Size.
+// The result is always encoded as png.
+func Process(data []byte) ([]byte, string, error) {
+	if len(data) > MaxUploadSize {
+		return nil, "", fmt.Errorf("avatar must not be larger than %d bytes", MaxUploadSize)
+	}
+
+	if !allowedTypes[http.DetectContentType(data)] {
+		return nil, "", ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedType
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, "", fmt.Errorf("avatar dimensions are too large")
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedType
+	}
+
+	// NOTE: take the centered square, avatars are shown in circles anyway
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		b.Min.X+(b.Dx()-side)/2,
+		b.Min.Y+(b.Dy()-side)/2,
+	))
+
+	dstSize := min(side, Size)
+	dst := image.NewNRGBA(image.Rect(0, 0, dstSize, dstSize))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), "image/png", nil
+}
+
+// ParseDataURI decodes a base64 "data:<type>;base64,<data>" uri as avatars used to be stored
+func ParseDataURI(uri string) ([]byte, string, error) {
+	meta, encoded, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok || !strings.HasPrefix(uri, "data:") || !strings.HasSuffix(meta, ";base64") {
+		return nil, "", fmt.Errorf("avatar is not a base64 data uri")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, "", err
+	}
+
+	contentType := strings.TrimSuffix(meta, ";base64")
+	if !allowedTypes[contentType] {
+		return nil, "", ErrUnsupportedType
+	}
+
+	return data, contentType, nil
+}
@@ -0,0 +1,11 @@
+package constant
+
+// ActivityKind is the enumeration for the entries of a user activity timeline
+type ActivityKind string
+
+const (
+	ActivityKindComment ActivityKind = "comment"
+	ActivityKindReply   ActivityKind = "reply"
+	ActivityKindEdit    ActivityKind = "edit"
+	ActivityKindVote    ActivityKind = "vote"
+)
@@ -0,0 +1,36 @@
+package constant
+
+// AuditAction is the enumeration for the actions recorded in the audit log
+type AuditAction string
+
+const (
+	AuditActionCommentDelete       AuditAction = "comment.delete"
+	AuditActionModerationApprove   AuditAction = "moderation.approve"
+	AuditActionModerationRemove    AuditAction = "moderation.remove"
+	AuditActionModerationDismiss   AuditAction = "moderation.dismiss"
+	AuditActionModerationSpamLabel AuditAction = "moderation.spam_label"
+	AuditActionThreadPin           AuditAction = "thread.pin"
+	AuditActionThreadUnpin         AuditAction = "thread.unpin"
+	AuditActionThreadFeature       AuditAction = "thread.feature"
+	AuditActionThreadUnfeature     AuditAction = "thread.unfeature"
+	AuditActionThreadLock          AuditAction = "thread.lock"
+	AuditActionThreadUnlock        AuditAction = "thread.unlock"
+	AuditActionUserRoleChange      AuditAction = "user.role_change"
+	AuditActionUserBan             AuditAction = "user.ban"
+	AuditActionUserUnban           AuditAction = "user.unban"
+	AuditActionFilterCreate        AuditAction = "filter.create"
+	AuditActionFilterUpdate        AuditAction = "filter.update"
+	AuditActionFilterDelete        AuditAction = "filter.delete"
+	AuditActionVoteRingNullify     AuditAction = "vote_ring.nullify"
+	AuditActionVoteRingDismiss     AuditAction = "vote_ring.dismiss"
+)
+
+// AuditTargetType is the enumeration for the kinds of records an audited action is applied to
+type AuditTargetType string
+
+const (
+	AuditTargetComment    AuditTargetType = "comment"
+	AuditTargetUser       AuditTargetType = "user"
+	AuditTargetFilterRule AuditTargetType = "filter_rule"
+	AuditTargetVoteRing   AuditTargetType = "vote_ring"
+)
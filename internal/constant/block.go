@@ -0,0 +1,19 @@
+package constant
+
+// BlockKind is the enumeration for the ways a user can silence another user
+type BlockKind string
+
+const (
+	// BlockKindBlock collapses content of the blocked user and forbids them to reply to or vote on blocker's comments
+	BlockKindBlock BlockKind = "block"
+	// BlockKindMute hides content of the muted user from the muter
+	BlockKindMute BlockKind = "mute"
+)
+
+// CollapseReason is the enumeration for the reasons a comment is returned collapsed
+type CollapseReason string
+
+const (
+	CollapseReasonBlocked  CollapseReason = "blocked"
+	CollapseReasonLowScore CollapseReason = "low_score"
+)
@@ -6,3 +6,5 @@ type contextKey struct {
 
 var UserCtxKey = &contextKey{"user"}
 var TokenKeyCtxKey = &contextKey{"token_key"}
+var RequestIDCtxKey = &contextKey{"request_id"}
+var RealIPCtxKey = &contextKey{"real_ip"}
@@ -0,0 +1,19 @@
+package constant
+
+// FilterKind is the enumeration for the checks a content filter rule performs
+type FilterKind string
+
+const (
+	FilterKindMaxLength   FilterKind = "max_length"
+	FilterKindBannedWords FilterKind = "banned_words"
+	FilterKindLinkLimit   FilterKind = "link_limit"
+)
+
+// FilterAction is the enumeration for what happens to content that breaks a filter rule
+type FilterAction string
+
+const (
+	FilterActionReject   FilterAction = "reject"
+	FilterActionMask     FilterAction = "mask"
+	FilterActionModerate FilterAction = "moderate"
+)
@@ -0,0 +1,25 @@
+package constant
+
+// MarkdownFeature is the enumeration for the markdown syntax which can be enabled in comments
+type MarkdownFeature string
+
+const (
+	// MarkdownFeatureEmphasis is *em* and **strong**
+	MarkdownFeatureEmphasis MarkdownFeature = "emphasis"
+	// MarkdownFeatureLinks is [text](url) and <url>
+	MarkdownFeatureLinks MarkdownFeature = "links"
+	// MarkdownFeatureCode is `code`, indented and fenced code blocks
+	MarkdownFeatureCode MarkdownFeature = "code"
+	// MarkdownFeatureQuotes is > quote
+	MarkdownFeatureQuotes MarkdownFeature = "quotes"
+	// MarkdownFeatureLists is - item and 1. item
+	MarkdownFeatureLists MarkdownFeature = "lists"
+)
+
+var MarkdownFeatures = []MarkdownFeature{
+	MarkdownFeatureEmphasis,
+	MarkdownFeatureLinks,
+	MarkdownFeatureCode,
+	MarkdownFeatureQuotes,
+	MarkdownFeatureLists,
+}
@@ -0,0 +1,75 @@
+package constant
+
+// CommentStatus is the enumeration for the visibility states of a comment
+type CommentStatus string
+
+const (
+	CommentStatusVisible CommentStatus = "visible"
+	CommentStatusHidden  CommentStatus = "hidden"
+	CommentStatusRemoved CommentStatus = "removed"
+	// CommentStatusScheduled is hidden until the comment is published at its publish time
+	CommentStatusScheduled CommentStatus = "scheduled"
+)
+
+// ReportReason is the enumeration for the categories a comment can be reported for
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonHateSpeech     ReportReason = "hate_speech"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonOffTopic       ReportReason = "off_topic"
+	ReportReasonOther          ReportReason = "other"
+)
+
+// ReportStatus is the enumeration for the states of a report
+type ReportStatus string
+
+const (
+	ReportStatusPending   ReportStatus = "pending"
+	ReportStatusUpheld    ReportStatus = "upheld"
+	ReportStatusRejected  ReportStatus = "rejected"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// ModerationAction is the enumeration for the decisions a moderator can make on a queued comment
+type ModerationAction string
+
+const (
+	ModerationActionApprove ModerationAction = "approve"
+	ModerationActionRemove  ModerationAction = "remove"
+	ModerationActionDismiss ModerationAction = "dismiss"
+)
+
+// SpamLabel is the enumeration for the labels a moderator trains the spam classifier with
+type SpamLabel string
+
+const (
+	SpamLabelSpam SpamLabel = "spam"
+	SpamLabelHam  SpamLabel = "ham"
+)
+
+// BanStatus is the enumeration for the sanctions applied to an account
+type BanStatus string
+
+const (
+	// BanStatusSuspended forbids writing until the ban expires
+	BanStatusSuspended BanStatus = "suspended"
+	// BanStatusShadowBanned lets the user write, but nobody else sees it
+	BanStatusShadowBanned BanStatus = "shadow_banned"
+	// BanStatusBanned forbids writing for good
+	BanStatusBanned BanStatus = "banned"
+)
+
+// ThreadFlag is the enumeration for the states a moderator can put a top-level comment in
+type ThreadFlag string
+
+const (
+	// ThreadFlagPin lists the thread before all others
+	ThreadFlagPin ThreadFlag = "pin"
+	// ThreadFlagFeature highlights the thread
+	ThreadFlagFeature ThreadFlag = "feature"
+	// ThreadFlagLock forbids new replies in the thread
+	ThreadFlagLock ThreadFlag = "lock"
+)
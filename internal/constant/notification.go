@@ -0,0 +1,9 @@
+package constant
+
+// NotificationKind is the enumeration for the events which land in the inbox of a user
+type NotificationKind string
+
+const (
+	// NotificationKindReply is a new reply in a subscribed thread
+	NotificationKindReply NotificationKind = "reply"
+)
@@ -0,0 +1,11 @@
+package constant
+
+// RateLimitScope is the enumeration for the groups of requests sharing a rate limit budget
+type RateLimitScope string
+
+const (
+	RateLimitScopeCreate RateLimitScope = "create"
+	RateLimitScopeEdit   RateLimitScope = "edit"
+	RateLimitScopeVote   RateLimitScope = "vote"
+	RateLimitScopeRead   RateLimitScope = "read"
+)
@@ -0,0 +1,10 @@
+package constant
+
+// Role is the enumeration for the roles a user can have
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
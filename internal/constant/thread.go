@@ -0,0 +1,11 @@
+package constant
+
+// ThreadStatus is the enumeration for the states top-level comments can be filtered by
+type ThreadStatus string
+
+const (
+	// ThreadStatusAnswered keeps threads with an accepted reply
+	ThreadStatusAnswered ThreadStatus = "answered"
+	// ThreadStatusUnanswered keeps threads without an accepted reply
+	ThreadStatusUnanswered ThreadStatus = "unanswered"
+)
@@ -0,0 +1,18 @@
+package constant
+
+// VoteRingStatus is the enumeration for the states of a detected vote ring
+type VoteRingStatus string
+
+const (
+	VoteRingStatusPending   VoteRingStatus = "pending"
+	VoteRingStatusNullified VoteRingStatus = "nullified"
+	VoteRingStatusDismissed VoteRingStatus = "dismissed"
+)
+
+// VoteRingAction is the enumeration for the decisions a moderator can make on a vote ring
+type VoteRingAction string
+
+const (
+	VoteRingActionNullify VoteRingAction = "nullify"
+	VoteRingActionDismiss VoteRingAction = "dismiss"
+)
@@ -0,0 +1,212 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+type Rule struct {
+	ID     int
+	Kind   constant.FilterKind
+	Action constant.FilterAction
+	Value  string
+}
+
+type Result struct {
+	Content string
+	// Moderate is set when a rule asked to send the content to the moderation queue
+	Moderate bool
+}
+
+// RejectedError is returned when content breaks a rule with the reject action
+type RejectedError struct {
+	RuleID  int
+	Message string
+}
+
+func (e *RejectedError) Error() string {
+	return e.Message
+}
+
+var (
+	linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+	wordRegexp = regexp.MustCompile(`[\p{L}\p{N}@$]+`)
+)
+
+var leetReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"8", "b",
+	"@", "a",
+	"$", "s",
+)
+
+// NOTE: 1 stands for l as often as for i, words with it are matched in both forms
+var leetAltReplacer = strings.NewReplacer("1", "l")
+
+// Apply runs content through rules in order. Masking rules change the content seen by the following rules.
+func Apply(content string, rules []*Rule) (*Result, error) {
+	res := &Result{Content: content}
+
+	for _, r := range rules {
+		violated, masked, msg, err := check(res.Content, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if !violated {
+			continue
+		}
+
+		switch r.Action {
+		case constant.FilterActionReject:
+			return nil, &RejectedError{RuleID: r.ID, Message: msg}
+		case constant.FilterActionMask:
+			res.Content = masked
+		case constant.FilterActionModerate:
+			res.Moderate = true
+		}
+	}
+
+	return res, nil
+}
+
+// ValidateRule checks that value can be used with the rule kind
+func ValidateRule(kind constant.FilterKind, value string) error {
+	switch kind {
+	case constant.FilterKindMaxLength, constant.FilterKindLinkLimit:
+		if n, err := strconv.Atoi(strings.TrimSpace(value)); err != nil || n < 0 {
+			return fmt.Errorf("value of %s rule must be a non negative number", kind)
+		}
+	case constant.FilterKindBannedWords:
+		if len(bannedWords(value)) == 0 {
+			return fmt.Errorf("value of %s rule must contain at least one word", kind)
+		}
+	default:
+		return fmt.Errorf("unknown rule kind %q", kind)
+	}
+
+	return nil
+}
+
+// check reports whether content breaks the rule, the masked content and the message for rejection
+func check(content string, r *Rule) (bool, string, string, error) {
+	if err := ValidateRule(r.Kind, r.Value); err != nil {
+		return false, "", "", err
+	}
+
+	switch r.Kind {
+	case constant.FilterKindMaxLength:
+		limit, _ := strconv.Atoi(strings.TrimSpace(r.Value))
+		if utf8.RuneCountInString(content) <= limit {
+			return false, content, "", nil
+		}
+
+		// NOTE: masking a too long content truncates it
+		return true, string([]rune(content)[:limit]), fmt.Sprintf("content is too long, maximum is %d characters", limit), nil
+	case constant.FilterKindLinkLimit:
+		limit, _ := strconv.Atoi(strings.TrimSpace(r.Value))
+		links := linkRegexp.FindAllStringIndex(content, -1)
+		if len(links) <= limit {
+			return false, content, "", nil
+		}
+
+		// NOTE: masking keeps the allowed number of links and hides the rest
+		masked := maskRanges(content, links[limit:])
+		return true, masked, fmt.Sprintf("content has too many links, maximum is %d", limit), nil
+	case constant.FilterKindBannedWords:
+		words := bannedWords(r.Value)
+		matches := make([][]int, 0)
+		for _, loc := range wordRegexp.FindAllStringIndex(content, -1) {
+			for _, w := range forms(content[loc[0]:loc[1]]) {
+				if _, ok := words[w]; ok {
+					matches = append(matches, loc)
+					break
+				}
+			}
+		}
+
+		if len(matches) == 0 {
+			return false, content, "", nil
+		}
+
+		return true, maskRanges(content, matches), "content contains banned words", nil
+	}
+
+	return false, content, "", nil
+}
+
+// bannedWords parses a comma or new line separated list of words
+func bannedWords(value string) map[string]struct{} {
+	words := make(map[string]struct{})
+
+	for _, w := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	}) {
+		for _, f := range forms(strings.TrimSpace(w)) {
+			if f != "" {
+				words[f] = struct{}{}
+			}
+		}
+	}
+
+	return words
+}
+
+// forms returns the normalized word, words with 1 are also normalized with 1 read as l
+func forms(word string) []string {
+	fs := []string{normalize(word)}
+	if strings.Contains(word, "1") {
+		fs = append(fs, normalize(leetAltReplacer.Replace(word)))
+	}
+
+	return fs
+}
+
+// normalize lowers the word, undoes leetspeak substitutions and squeezes letters repeated three and more times
+func normalize(word string) string {
+	word = leetReplacer.Replace(strings.ToLower(word))
+
+	var b strings.Builder
+	runes := []rune(word)
+	for i := 0; i < len(runes); i++ {
+		j := i
+		for j+1 < len(runes) && runes[j+1] == runes[i] {
+			j++
+		}
+
+		if j-i >= 2 {
+			b.WriteRune(runes[i])
+		} else {
+			b.WriteString(string(runes[i : j+1]))
+		}
+
+		i = j
+	}
+
+	return b.String()
+}
+
+// maskRanges replaces every rune inside the byte ranges with an asterisk
+func maskRanges(content string, ranges [][]int) string {
+	var b strings.Builder
+
+	last := 0
+	for _, loc := range ranges {
+		b.WriteString(content[last:loc[0]])
+		b.WriteString(strings.Repeat("*", utf8.RuneCountInString(content[loc[0]:loc[1]])))
+		last = loc[1]
+	}
+	b.WriteString(content[last:])
+
+	return b.String()
+}
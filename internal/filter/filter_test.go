@@ -0,0 +1,85 @@
+package filter
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want string
+	}{
+		{"lower case", "Spam", "spam"},
+		{"leetspeak", "5p4m", "spam"},
+		{"leetspeak symbols", "$c@m", "scam"},
+		{"leetspeak digits", "1d10t", "idiot"},
+		{"double letters stay", "book", "book"},
+		{"triple letters squeeze", "spaaam", "spam"},
+		{"long repeat squeezes", "nooooo", "no"},
+		{"leetspeak then squeeze", "l0000ser", "loser"},
+		{"unicode", "ПРИВЕЕЕТ", "привет"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalize(tt.word); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForms(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want []string
+	}{
+		{"without 1", "5p4m", []string{"spam"}},
+		{"1 as i or l", "1oser", []string{"ioser", "loser"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := forms(tt.word); !slices.Equal(got, tt.want) {
+				t.Errorf("forms(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyBannedWords(t *testing.T) {
+	rules := []*Rule{
+		{ID: 1, Kind: constant.FilterKindBannedWords, Action: constant.FilterActionMask, Value: "spam, scam, loser, idiot"},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"clean", "hello there", "hello there"},
+		{"plain word", "this is spam", "this is ****"},
+		{"leetspeak", "this is 5p4m", "this is ****"},
+		{"repeated letters", "what a sCAAAAM", "what a *******"},
+		{"part of a word", "spammer", "spammer"},
+		{"1 read as l", "what a 1oser", "what a *****"},
+		{"1 read as i", "what an 1d10t", "what an *****"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Apply(tt.content, rules)
+			if err != nil {
+				t.Fatalf("Apply(%q) error = %v", tt.content, err)
+			}
+			if res.Content != tt.want {
+				t.Errorf("Apply(%q) = %q, want %q", tt.content, res.Content, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"slices"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	goldmarkHtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+// linkRel is set on every link, user content must not pass on reputation
+const linkRel = "nofollow ugc"
+
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+// New creates a renderer which only understands the given features. Raw html, headings, images
+// and everything else outside the features stays plain text.
+func New(features []constant.MarkdownFeature) *Renderer {
+	blockParsers := []util.PrioritizedValue{
+		util.Prioritized(parser.NewParagraphParser(), 1000),
+	}
+	// NOTE: references to other comments work regardless of features
+	inlineParsers := []util.PrioritizedValue{
+		util.Prioritized(commentRefParser{}, 600),
+	}
+	paragraphTransformers := make([]util.PrioritizedValue, 0)
+
+	// NOTE: priorities are the ones goldmark uses for commonmark
+	if slices.Contains(features, constant.MarkdownFeatureEmphasis) {
+		inlineParsers = append(inlineParsers, util.Prioritized(parser.NewEmphasisParser(), 500))
+	}
+	if slices.Contains(features, constant.MarkdownFeatureLinks) {
+		inlineParsers = append(
+			inlineParsers,
+			util.Prioritized(parser.NewLinkParser(), 200),
+			util.Prioritized(parser.NewAutoLinkParser(), 300),
+		)
+		paragraphTransformers = append(paragraphTransformers, util.Prioritized(parser.LinkReferenceParagraphTransformer, 100))
+	}
+	if slices.Contains(features, constant.MarkdownFeatureCode) {
+		inlineParsers = append(inlineParsers, util.Prioritized(parser.NewCodeSpanParser(), 100))
+		blockParsers = append(
+			blockParsers,
+			util.Prioritized(parser.NewCodeBlockParser(), 500),
+			util.Prioritized(parser.NewFencedCodeBlockParser(), 700),
+		)
+	}
+	if slices.Contains(features, constant.MarkdownFeatureQuotes) {
+		blockParsers = append(blockParsers, util.Prioritized(quoteParser{parser.NewBlockquoteParser()}, 800))
+	}
+	if slices.Contains(features, constant.MarkdownFeatureLists) {
+		blockParsers = append(
+			blockParsers,
+			util.Prioritized(parser.NewListParser(), 300),
+			util.Prioritized(parser.NewListItemParser(), 400),
+		)
+	}
+
+	md := goldmark.New(
+		goldmark.WithParser(parser.NewParser(
+			parser.WithBlockParsers(blockParsers...),
+			parser.WithInlineParsers(inlineParsers...),
+			parser.WithParagraphTransformers(paragraphTransformers...),
+			parser.WithASTTransformers(util.Prioritized(linkRelTransformer{}, 100)),
+		)),
+		// NOTE: comments are written in a textarea, a new line is meant as a line break
+		goldmark.WithRendererOptions(
+			goldmarkHtml.WithHardWraps(),
+			renderer.WithNodeRenderers(util.Prioritized(commentRefRenderer{}, 100)),
+		),
+	)
+
+	return &Renderer{
+		md:     md,
+		policy: newPolicy(),
+	}
+}
+
+// Render converts markdown source to sanitized html. ">>123" references become links
+// when 123 is one of refs, the ids of comments the source was checked to refer to.
+func (r *Renderer) Render(src string, refs []int) string {
+	mRefs := make(map[int]bool, len(refs))
+	for _, id := range refs {
+		mRefs[id] = true
+	}
+
+	pc := parser.NewContext()
+	pc.Set(refsKey, mRefs)
+
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(src), &buf, parser.WithContext(pc)); err != nil {
+		return "<p>" + html.EscapeString(src) + "</p>"
+	}
+
+	return r.policy.Sanitize(buf.String())
+}
+
+// References returns ids of comments the source refers to with ">>123", in order of appearance
+func (r *Renderer) References(src string) []int {
+	pc := parser.NewContext()
+	pc.Set(collectKey, true)
+
+	doc := r.md.Parser().Parse(text.NewReader([]byte(src)), parser.WithContext(pc))
+
+	ids := make([]int, 0)
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if ref, ok := n.(*commentRef); ok && entering && !slices.Contains(ids, ref.ID) {
+			ids = append(ids, ref.ID)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return ids
+}
+
+// newPolicy allows only the elements the enabled syntax can produce, as a second line of defense
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements("p", "br", "em", "strong", "code", "pre", "blockquote", "ul", "ol", "li")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowAttrs("rel").Matching(regexp.MustCompile(`^` + linkRel + `$`)).OnElements("a")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^comment-ref$`)).OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	// NOTE: needed for "#comment-123" of references, other links are checked by linkRelTransformer
+	p.AllowRelativeURLs(true)
+	p.RequireNoFollowOnLinks(true)
+
+	return p
+}
+
+// linkRelTransformer marks links as user generated. Links to anything but http, https and mailto urls,
+// and images, are replaced by their text.
+type linkRelTransformer struct{}
+
+func (linkRelTransformer) Transform(doc *ast.Document, reader text.Reader, _ parser.Context) {
+	source := reader.Source()
+
+	unwrap := make([]ast.Node, 0)
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Link:
+			if isSafeURL(n.Destination) {
+				n.SetAttributeString("rel", []byte(linkRel))
+			} else {
+				unwrap = append(unwrap, n)
+			}
+		case *ast.AutoLink:
+			if n.AutoLinkType == ast.AutoLinkEmail || isSafeURL(n.URL(source)) {
+				n.SetAttributeString("rel", []byte(linkRel))
+			} else {
+				unwrap = append(unwrap, n)
+			}
+		case *ast.Image:
+			unwrap = append(unwrap, n)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range unwrap {
+		parent := n.Parent()
+		if a, ok := n.(*ast.AutoLink); ok {
+			parent.InsertBefore(parent, n, ast.NewString(a.Label(source)))
+		}
+		for c := n.FirstChild(); c != nil; c = n.FirstChild() {
+			parent.InsertBefore(parent, n, c)
+		}
+		parent.RemoveChild(parent, n)
+	}
+}
+
+func isSafeURL(url []byte) bool {
+	url = bytes.ToLower(bytes.TrimSpace(url))
+
+	return bytes.HasPrefix(url, []byte("http://")) ||
+		bytes.HasPrefix(url, []byte("https://")) ||
+		bytes.HasPrefix(url, []byte("mailto:"))
+}
@@ -0,0 +1,107 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindCommentRef is the node kind of ">>123" references to other comments
+var KindCommentRef = ast.NewNodeKind("CommentRef")
+
+type commentRef struct {
+	ast.BaseInline
+	ID int
+}
+
+func (n *commentRef) Kind() ast.NodeKind {
+	return KindCommentRef
+}
+
+func (n *commentRef) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": strconv.Itoa(n.ID)}, nil)
+}
+
+var (
+	commentRefPattern = regexp.MustCompile(`^>>(\d{1,18})\b`)
+	// refsKey holds ids of comments which may be linked, references to other ids stay text
+	refsKey = parser.NewContextKey()
+	// collectKey marks parsing which only collects references, every id is accepted
+	collectKey = parser.NewContextKey()
+)
+
+type commentRefParser struct{}
+
+func (commentRefParser) Trigger() []byte {
+	return []byte{'>'}
+}
+
+func (commentRefParser) Parse(_ ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	if c := block.PrecendingCharacter(); c == '>' || unicode.IsLetter(c) || unicode.IsDigit(c) {
+		return nil
+	}
+
+	line, _ := block.PeekLine()
+	m := commentRefPattern.FindSubmatch(line)
+	if m == nil {
+		return nil
+	}
+
+	id, err := strconv.Atoi(string(m[1]))
+	if err != nil {
+		return nil
+	}
+
+	if pc.Get(collectKey) == nil {
+		refs, _ := pc.Get(refsKey).(map[int]bool)
+		if !refs[id] {
+			return nil
+		}
+	}
+
+	block.Advance(len(m[0]))
+	return &commentRef{ID: id}
+}
+
+// quoteParser is the commonmark blockquote, except for lines starting with a reference
+type quoteParser struct {
+	parser.BlockParser
+}
+
+func (p quoteParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, _ := reader.PeekLine()
+	if commentRefPattern.Match(util.TrimLeftSpace(line)) {
+		return nil, parser.NoChildren
+	}
+
+	return p.BlockParser.Open(parent, reader, pc)
+}
+
+func (p quoteParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if commentRefPattern.Match(util.TrimLeftSpace(line)) {
+		return parser.Close
+	}
+
+	return p.BlockParser.Continue(node, reader, pc)
+}
+
+type commentRefRenderer struct{}
+
+func (commentRefRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindCommentRef, func(w util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			id := node.(*commentRef).ID
+			_, _ = fmt.Fprintf(w, `<a href="#comment-%d" class="comment-ref">&gt;&gt;%d</a>`, id, id)
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+}
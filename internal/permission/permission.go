@@ -0,0 +1,49 @@
+package permission
+
+import (
+	"github.com/talgat-ruby/interactive-comments-api/internal/constant"
+)
+
+// Permission is the name of an action which is not allowed to every user
+type Permission string
+
+const (
+	CommentEditAny   Permission = "comment:edit_any"
+	CommentDeleteAny Permission = "comment:delete_any"
+	// CommentRemoveAny takes down comments of others by marking them removed, they are kept for the audit trail
+	CommentRemoveAny Permission = "comment:remove_any"
+	ModerationManage Permission = "moderation:manage"
+	UserSanction     Permission = "user:sanction"
+	UserManage       Permission = "user:manage"
+	FilterManage     Permission = "filter:manage"
+	StatsRead        Permission = "stats:read"
+)
+
+var rolePermissions = map[constant.Role][]Permission{
+	constant.RoleUser: {},
+	constant.RoleModerator: {
+		CommentRemoveAny,
+		ModerationManage,
+		UserSanction,
+	},
+	constant.RoleAdmin: {
+		CommentEditAny,
+		CommentDeleteAny,
+		ModerationManage,
+		UserSanction,
+		UserManage,
+		FilterManage,
+		StatsRead,
+	},
+}
+
+// Has reports whether the role is granted the permission
+func Has(role constant.Role, p Permission) bool {
+	for _, rp := range rolePermissions[role] {
+		if rp == p {
+			return true
+		}
+	}
+
+	return false
+}
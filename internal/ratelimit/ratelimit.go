@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit lets Burst requests through at once and refills the bucket with Burst tokens every Per
+type Limit struct {
+	Burst int
+	Per   time.Duration
+}
+
+// Bucket is the state of a single token bucket
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	// FullAt is when the bucket is refilled completely and can be forgotten
+	FullAt time.Time
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long to wait for the next token, zero when allowed
+	RetryAfter time.Duration
+	// Reset is how long until the budget is fully restored
+	Reset time.Duration
+}
+
+// Store keeps token buckets in memory
+type Store struct {
+	mu      sync.Mutex
+	buckets map[string]*Bucket
+}
+
+func NewStore() *Store {
+	return &Store{
+		buckets: make(map[string]*Bucket),
+	}
+}
+
+// Take spends a token from every bucket of keys. The request is allowed only when all buckets have a token,
+// otherwise nothing is spent.
+func (s *Store) Take(now time.Time, limit Limit, keys ...string) *Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := &Result{
+		Allowed:   true,
+		Limit:     limit.Burst,
+		Remaining: limit.Burst,
+	}
+
+	if limit.Burst <= 0 || limit.Per <= 0 {
+		res.Allowed = false
+		res.RetryAfter = limit.Per
+		return res
+	}
+
+	rate := float64(limit.Burst) / limit.Per.Seconds()
+
+	buckets := make([]*Bucket, len(keys))
+	for i, key := range keys {
+		b := s.refill(now, key, limit, rate)
+		buckets[i] = b
+
+		if b.Tokens < 1 {
+			res.Allowed = false
+			wait := time.Duration((1 - b.Tokens) / rate * float64(time.Second))
+			res.RetryAfter = max(res.RetryAfter, wait)
+		}
+	}
+
+	for _, b := range buckets {
+		if res.Allowed {
+			b.Tokens--
+		}
+		b.FullAt = now.Add(time.Duration((float64(limit.Burst) - b.Tokens) / rate * float64(time.Second)))
+
+		res.Remaining = min(res.Remaining, int(math.Floor(b.Tokens)))
+		res.Reset = max(res.Reset, b.FullAt.Sub(now))
+	}
+
+	return res
+}
+
+func (s *Store) refill(now time.Time, key string, limit Limit, rate float64) *Bucket {
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &Bucket{
+			Tokens:    float64(limit.Burst),
+			UpdatedAt: now,
+		}
+		s.buckets[key] = b
+	}
+
+	if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(float64(limit.Burst), b.Tokens+elapsed*rate)
+		b.UpdatedAt = now
+	}
+
+	return b
+}
+
+// Cleanup forgets buckets which are full again, they behave exactly like missing ones
+func (s *Store) Cleanup(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, b := range s.buckets {
+		if !now.Before(b.FullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// Snapshot returns a copy of all buckets
+func (s *Store) Snapshot() map[string]Bucket {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := make(map[string]Bucket, len(s.buckets))
+	for key, b := range s.buckets {
+		snap[key] = *b
+	}
+
+	return snap
+}
+
+// Restore puts buckets into the store, replacing the ones with the same key
+func (s *Store) Restore(buckets map[string]Bucket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, b := range buckets {
+		b := b
+		s.buckets[key] = &b
+	}
+}
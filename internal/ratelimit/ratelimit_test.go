@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTakeZeroBudget(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		limit Limit
+	}{
+		{"zero burst", Limit{Burst: 0, Per: time.Minute}},
+		{"negative burst", Limit{Burst: -1, Per: time.Minute}},
+		{"zero period", Limit{Burst: 10, Per: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore()
+
+			res := s.Take(now, tt.limit, "ip:1")
+			if res.Allowed {
+				t.Errorf("Take() allowed a request with limit %+v", tt.limit)
+			}
+			if len(s.Snapshot()) != 0 {
+				t.Errorf("Take() created a bucket with limit %+v", tt.limit)
+			}
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := Limit{Burst: 2, Per: time.Minute}
+
+	tests := []struct {
+		name          string
+		after         time.Duration
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		{"first request", 0, true, 1, 0},
+		{"second request", 0, true, 0, 0},
+		{"budget spent", 0, false, 0, 30 * time.Second},
+		{"still empty", 10 * time.Second, false, 0, 20 * time.Second},
+		{"one token refilled", 30 * time.Second, true, 0, 0},
+	}
+
+	s := NewStore()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := s.Take(now.Add(tt.after), limit, "ip:1")
+			if res.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", res.Allowed, tt.wantAllowed)
+			}
+			if res.Remaining != tt.wantRemaining {
+				t.Errorf("Remaining = %d, want %d", res.Remaining, tt.wantRemaining)
+			}
+			if res.RetryAfter != tt.wantRetry {
+				t.Errorf("RetryAfter = %v, want %v", res.RetryAfter, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestTakeAfterRestore(t *testing.T) {
+	savedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := Limit{Burst: 6, Per: time.Minute}
+
+	tests := []struct {
+		name          string
+		tokens        float64
+		after         time.Duration
+		wantAllowed   bool
+		wantRemaining int
+	}{
+		{"empty bucket right away", 0, 0, false, 0},
+		{"empty bucket refilled one token", 0, 10 * time.Second, true, 0},
+		{"empty bucket refilled half", 0, 30 * time.Second, true, 2},
+		{"refill stops at burst", 1, time.Hour, true, 5},
+		{"partial bucket", 3, 0, true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore()
+			s.Restore(map[string]Bucket{
+				"ip:1": {Tokens: tt.tokens, UpdatedAt: savedAt, FullAt: savedAt.Add(time.Minute)},
+			})
+
+			res := s.Take(savedAt.Add(tt.after), limit, "ip:1")
+			if res.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", res.Allowed, tt.wantAllowed)
+			}
+			if res.Remaining != tt.wantRemaining {
+				t.Errorf("Remaining = %d, want %d", res.Remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestTakeSpendsAllOrNothing(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := Limit{Burst: 1, Per: time.Minute}
+
+	s := NewStore()
+	s.Take(now, limit, "user:amy")
+
+	if res := s.Take(now, limit, "ip:1", "user:amy"); res.Allowed {
+		t.Fatalf("Take() allowed a request with an empty bucket")
+	}
+	if res := s.Take(now, limit, "ip:1"); !res.Allowed {
+		t.Errorf("Take() spent a token of a rejected request")
+	}
+}
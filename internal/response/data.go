@@ -7,3 +7,14 @@ type Data struct {
 type DataWithMessage struct {
 	Data WithMessage `json:"data"`
 }
+
+type DataWithPagination struct {
+	Data       interface{} `json:"data"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+type Pagination struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	Total  int `json:"total"`
+}
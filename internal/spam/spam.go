@@ -0,0 +1,172 @@
+package spam
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// interestingTokens is how many tokens with the strongest opinion are combined by the classifier
+	interestingTokens = 15
+	// minTokenDocs is how many times a token has to be seen in training before the classifier trusts it
+	minTokenDocs = 2
+)
+
+var (
+	tokenRegexp = regexp.MustCompile(`[\p{L}\p{N}']+`)
+	linkRegexp  = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+)
+
+// TokenCount is how many spam and ham training documents contained a token
+type TokenCount struct {
+	Spam int
+	Ham  int
+}
+
+// Corpus is the training data of the naive Bayes classifier
+type Corpus struct {
+	SpamDocs int
+	HamDocs  int
+	Tokens   map[string]TokenCount
+}
+
+// Signals are the facts about a comment and its author the heuristics look at
+type Signals struct {
+	Content string
+	// Duplicates is how many recent comments have exactly the same content
+	Duplicates int
+	// AccountAge is how long ago the author signed up
+	AccountAge time.Duration
+	// RecentComments is how many comments the author posted during the last hour
+	RecentComments int
+}
+
+// Tokenize splits content into the unique lower case tokens the classifier is trained on.
+// Links are reduced to their host, so every link to the same site is one token.
+func Tokenize(content string) []string {
+	seen := make(map[string]struct{})
+	tokens := make([]string, 0)
+
+	add := func(t string) {
+		if _, ok := seen[t]; ok {
+			return
+		}
+		seen[t] = struct{}{}
+		tokens = append(tokens, t)
+	}
+
+	for _, link := range linkRegexp.FindAllString(content, -1) {
+		if !strings.Contains(link, "://") {
+			link = "http://" + link
+		}
+		if u, err := url.Parse(link); err == nil && u.Host != "" {
+			add("host:" + strings.ToLower(u.Host))
+		}
+	}
+
+	for _, t := range tokenRegexp.FindAllString(linkRegexp.ReplaceAllString(content, " "), -1) {
+		if n := utf8.RuneCountInString(t); n < 2 || n > 40 {
+			continue
+		}
+		add(strings.ToLower(t))
+	}
+
+	return tokens
+}
+
+// Score returns the spam probability of a comment between 0 and 1.
+// Classifier and heuristic scores are combined as independent evidence, so any strong signal is enough.
+func Score(c *Corpus, s *Signals) float64 {
+	scores := []float64{
+		bayesScore(c, Tokenize(s.Content)),
+		linkDensityScore(s.Content),
+		repeatScore(s.Duplicates),
+		velocityScore(s.AccountAge, s.RecentComments),
+	}
+
+	ham := 1.0
+	for _, sc := range scores {
+		ham *= 1 - sc
+	}
+
+	return 1 - ham
+}
+
+// bayesScore is the naive Bayes spam probability rescaled so that a neutral 0.5 counts as no evidence
+func bayesScore(c *Corpus, tokens []string) float64 {
+	if c == nil || c.SpamDocs == 0 || c.HamDocs == 0 {
+		return 0
+	}
+
+	probs := make([]float64, 0, len(tokens))
+	for _, t := range tokens {
+		tc, ok := c.Tokens[t]
+		if !ok || tc.Spam+tc.Ham < minTokenDocs {
+			continue
+		}
+
+		spamFreq := float64(tc.Spam) / float64(c.SpamDocs)
+		hamFreq := float64(tc.Ham) / float64(c.HamDocs)
+		p := spamFreq / (spamFreq + hamFreq)
+
+		// NOTE: Robinson's adjustment pulls rarely seen tokens towards neutral
+		n := float64(tc.Spam + tc.Ham)
+		p = (0.5 + n*p) / (1 + n)
+
+		probs = append(probs, math.Min(math.Max(p, 0.01), 0.99))
+	}
+
+	if len(probs) == 0 {
+		return 0
+	}
+
+	sort.Slice(probs, func(i, j int) bool {
+		return math.Abs(probs[i]-0.5) > math.Abs(probs[j]-0.5)
+	})
+	if len(probs) > interestingTokens {
+		probs = probs[:interestingTokens]
+	}
+
+	logOdds := 0.0
+	for _, p := range probs {
+		logOdds += math.Log(p) - math.Log(1-p)
+	}
+	p := 1 / (1 + math.Exp(-logOdds))
+
+	return math.Max(0, (p-0.5)*2)
+}
+
+func linkDensityScore(content string) float64 {
+	links := len(linkRegexp.FindAllString(content, -1))
+	if links == 0 {
+		return 0
+	}
+
+	words := len(strings.Fields(content))
+	density := float64(links) / float64(max(words, 1))
+
+	// NOTE: links alone never quarantine a comment, they only add up with other signals
+	return math.Min(0.8, density*1.5)
+}
+
+func repeatScore(duplicates int) float64 {
+	if duplicates == 0 {
+		return 0
+	}
+
+	return math.Min(1, 0.5+0.1*float64(duplicates))
+}
+
+func velocityScore(accountAge time.Duration, recentComments int) float64 {
+	limit := 20.0
+	if accountAge < 24*time.Hour {
+		limit = 5
+	}
+
+	return math.Min(1, math.Max(0, float64(recentComments)-1)/limit)
+}
@@ -0,0 +1,107 @@
+package spam
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBayesScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		corpus *Corpus
+		tokens []string
+		want   float64
+	}{
+		{
+			name:   "nil corpus",
+			corpus: nil,
+			tokens: []string{"buy"},
+			want:   0,
+		},
+		{
+			name:   "empty corpus",
+			corpus: &Corpus{Tokens: map[string]TokenCount{}},
+			tokens: []string{"buy"},
+			want:   0,
+		},
+		{
+			name:   "no ham trained",
+			corpus: &Corpus{SpamDocs: 10, Tokens: map[string]TokenCount{"buy": {Spam: 10}}},
+			tokens: []string{"buy"},
+			want:   0,
+		},
+		{
+			name:   "unknown token",
+			corpus: &Corpus{SpamDocs: 10, HamDocs: 10, Tokens: map[string]TokenCount{"buy": {Spam: 5}}},
+			tokens: []string{"hello"},
+			want:   0,
+		},
+		{
+			name:   "token seen too rarely",
+			corpus: &Corpus{SpamDocs: 10, HamDocs: 10, Tokens: map[string]TokenCount{"buy": {Spam: 1}}},
+			tokens: []string{"buy"},
+			want:   0,
+		},
+		{
+			// NOTE: p = 1 is pulled to (0.5 + 2*1) / (1 + 2) = 5/6
+			name:   "robinson pulls rare spam token to neutral",
+			corpus: &Corpus{SpamDocs: 10, HamDocs: 10, Tokens: map[string]TokenCount{"buy": {Spam: 2}}},
+			tokens: []string{"buy"},
+			want:   2.0 / 3,
+		},
+		{
+			name:   "frequent spam token is clamped",
+			corpus: &Corpus{SpamDocs: 100, HamDocs: 100, Tokens: map[string]TokenCount{"buy": {Spam: 98}}},
+			tokens: []string{"buy"},
+			want:   0.98,
+		},
+		{
+			name:   "neutral token",
+			corpus: &Corpus{SpamDocs: 10, HamDocs: 10, Tokens: map[string]TokenCount{"the": {Spam: 4, Ham: 4}}},
+			tokens: []string{"the"},
+			want:   0,
+		},
+		{
+			name:   "ham token is no evidence",
+			corpus: &Corpus{SpamDocs: 10, HamDocs: 10, Tokens: map[string]TokenCount{"thanks": {Ham: 8}}},
+			tokens: []string{"thanks"},
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bayesScore(tt.corpus, tt.tokens); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("bayesScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreEmptyCorpus(t *testing.T) {
+	tests := []struct {
+		name    string
+		signals *Signals
+		want    float64
+	}{
+		{
+			name:    "plain comment",
+			signals: &Signals{Content: "Great work on this project!", AccountAge: 365 * 24 * time.Hour, RecentComments: 1},
+			want:    0,
+		},
+		{
+			name:    "duplicate comment",
+			signals: &Signals{Content: "Great work on this project!", Duplicates: 5, AccountAge: 365 * 24 * time.Hour},
+			want:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Score(&Corpus{}, tt.signals); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -39,11 +39,12 @@ func main() {
 	srv := api.New(log.With("service", constant.Api), conf.Api)
 	log.InfoContext(ctx, "initialize service", "service", "api")
 	// start gateway service
-	srv.Start(ctx, cancel, d)
+	done := srv.Start(ctx, cancel, d)
 
 	<-ctx.Done()
 	// Your cleanup tasks go here
 	log.InfoContext(ctx, "cleaning up ...")
+	<-done
 
 	log.InfoContext(ctx, "server was successful shutdown.")
 }